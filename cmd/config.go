@@ -0,0 +1,163 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/meloncoffee/weblin/config"
+	"github.com/meloncoffee/weblin/pkg/utils/file"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage weblin config file",
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init [path]",
+	Short: "Write a default config file",
+	Long: `Write a default config file with every option and its default value.
+The format follows the file extension: .toml writes TOML, .json writes JSON and
+anything else writes the commented YAML.
+Without a path, the --config path or ` + config.ConfFilePath + ` under the executable directory is used.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: WrapCmdFuncForCobra(oper.configInit),
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate [path]",
+	Short: "Validate a config file without starting weblin",
+	Long: `Load a config file and run the same validation as start.
+Without a path, the --config path or ` + config.ConfFilePath + ` under the executable directory is used.
+Exits with code 5 when the config is invalid.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: WrapCmdFuncForCobra(oper.configValidate),
+}
+
+// init 패키지 임포트 시 초기화
+func init() {
+	configInitCmd.Flags().Bool("force", false, "overwrite an existing config file")
+	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configValidateCmd)
+}
+
+// configInit 기본 설정 파일 생성
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (o *operation) configInit(cmd *cobra.Command) error {
+	path, err := o.configPathArg(cmd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 기존 설정 파일은 --force 옵션이 있을 때만 덮어씀
+	force, _ := cmd.Flags().GetBool("force")
+	if file.IsFileExists(path) && !force {
+		err := fmt.Errorf("%s already exists (use --force to overwrite)", path)
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 설정 파일 확장자에 맞는 형식으로 작성 (LoadConfig가 확장자로 형식을 판별)
+	data, err := config.DefaultConfigFile(path)
+	if err != nil {
+		err = fmt.Errorf("failed to encode default config: %v", err)
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	err = file.WriteDataToTextFile(path, string(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] Default config written to %s\n", path)
+	return nil
+}
+
+// configValidate 설정 파일 유효성 검사
+//
+// 서버를 가동하거나 데몬화하지 않고 설정 파일 로드 및 유효성 검사만 수행
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (o *operation) configValidate(cmd *cobra.Command) error {
+	path, err := o.configPathArg(cmd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 설정 파일의 상대 경로(TLS 인증서 등)가 가동 시와 같은 기준으로 해석되도록 작업 경로 변경
+	err = o.changeWorkPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	conf := config.DefaultConfig()
+	err = conf.LoadConfig(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %s: %v\n", path, err)
+		return newExitError(ExitConfigInvalid, err)
+	}
+
+	for _, warning := range config.LoadWarnings() {
+		fmt.Fprintf(os.Stderr, "[WARNING] %s\n", warning)
+	}
+	fmt.Fprintf(os.Stdout, "[INFO] %s is valid\n", path)
+
+	return nil
+}
+
+// configPathArg config 하위 명령어의 대상 설정 파일 경로 획득
+//
+// 인자로 지정된 경로, --config 플래그 경로, 실행 파일 경로 기준 기본 경로 순으로 사용
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//
+// Returns:
+//   - string: 설정 파일 절대 경로
+//   - error: 성공(nil), 실패(error)
+func (o *operation) configPathArg(cmd *cobra.Command) (string, error) {
+	if args := cmd.Flags().Args(); len(args) > 0 {
+		return filepath.Abs(args[0])
+	}
+	if config.RunConf.ConfFilePath != "" {
+		return filepath.Abs(config.RunConf.ConfFilePath)
+	}
+
+	exePath, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to absolute path: %v", err)
+	}
+	return filepath.Join(filepath.Dir(exePath), config.ConfFilePath), nil
+}
@@ -17,12 +17,18 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sort"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -32,9 +38,17 @@ import (
 	"github.com/meloncoffee/weblin/pkg/utils/file"
 	"github.com/meloncoffee/weblin/pkg/utils/goroutine"
 	"github.com/meloncoffee/weblin/pkg/utils/process"
+	"github.com/meloncoffee/weblin/pkg/utils/resource"
+	"github.com/meloncoffee/weblin/pkg/utils/systemd"
 	"github.com/spf13/cobra"
 )
 
+// 샘플러 작업 패닉 발생 시 최대 재시작 횟수
+const samplerMaxRestarts = 5
+
+// restart 명령어의 기존 프로세스 종료 확인 주기
+const exitPollInterval = 100 * time.Millisecond
+
 var oper operation
 
 var startCmd = &cobra.Command{
@@ -55,7 +69,33 @@ var stopCmd = &cobra.Command{
 	RunE:  WrapCmdFuncForCobra(oper.stop),
 }
 
-type operation struct{}
+var restartCmd = &cobra.Command{
+	Use:   "restart",
+	Short: "Restart weblin (normal)",
+	RunE:  WrapCmdFuncForCobra(oper.restart),
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show weblin status",
+	RunE:  WrapCmdFuncForCobra(oper.status),
+}
+
+var reloadCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reload weblin config and reopen log files",
+	RunE:  WrapCmdFuncForCobra(oper.reload),
+}
+
+type operation struct {
+	// 백그라운드 리소스 사용률 샘플러 (collectOnScrape 모드에서는 nil)
+	sampler *resource.Sampler
+	// 마지막으로 로드한 설정 파일의 SHA-256 해시 (변경되지 않은 설정 파일은 재로드 생략)
+	confChecksum string
+	// 현재 적용 중인 설정 (설정 재로드 시 변경 항목 비교용, 메인 고루틴에서만 접근)
+	// 다른 고루틴이 읽는 config.Conf는 가동 이후 변경하지 않음
+	appliedConf config.Config
+}
 
 // start weblin 모듈 가동
 //
@@ -65,8 +105,16 @@ type operation struct{}
 // Returns:
 //   - error: 정상 종료(nil), 비정상 종료(error)
 func (o *operation) start(cmd *cobra.Command) error {
+	// --config 플래그로 지정된 상대 경로를 현재 경로 기준 절대 경로로 변환
+	// (작업 경로 변경 이전에 변환해야 함)
+	err := o.resolveConfPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
 	// 작업 경로를 실행 파일이 위치한 경로로 변경
-	err := o.changeWorkPath()
+	err = o.changeWorkPath()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
 		return err
@@ -76,32 +124,110 @@ func (o *operation) start(cmd *cobra.Command) error {
 	var pid int
 	if o.isRunning(&pid, config.PidFilePath) {
 		fmt.Fprintf(os.Stdout, "[INFO] weblin is already running. (pid:%d)\n", pid)
-		return nil
+		return newExitError(ExitAlreadyRunning, fmt.Errorf("already running (pid:%d)", pid))
+	}
+	// PID 파일 기록 전에 가동 중인 프로세스가 잠금을 보유하고 있는지 확인
+	if lock, err := file.LockFile(config.PidLockFilePath); err == nil {
+		file.UnlockFile(lock)
+	} else if errors.Is(err, file.ErrLocked) {
+		fmt.Fprintf(os.Stdout, "[INFO] weblin is already running.\n")
+		return newExitError(ExitAlreadyRunning, fmt.Errorf("already running"))
 	}
 
-	// 데몬 프로세스 생성
-	err = process.DaemonizeProcess()
+	// 설정 파일 로드 (데몬화 이전에 로드하여 에러를 터미널에 출력)
+	// --config로 지정하지 않은 기본 경로에 설정 파일이 없으면 기본 설정 값으로 가동
+	confPath := config.RunConf.ConfPath()
+	_, statErr := os.Stat(confPath)
+	confMissing := config.RunConf.ConfFilePath == "" && errors.Is(statErr, os.ErrNotExist)
+	if confMissing {
+		fmt.Fprintf(os.Stderr, "[WARNING] Config file not found (%s), using defaults\n", confPath)
+		err = config.Conf.LoadDefaults()
+	} else {
+		err = config.Conf.LoadConfig(confPath)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
-		return err
+		return newExitError(ExitConfigInvalid, err)
+	}
+	if !confMissing {
+		o.confChecksum, _ = file.FileSHA256(confPath)
+	}
+	o.appliedConf = config.Conf
+
+	// 리스닝 포트 바인딩 권한 확인
+	err = o.checkPortPermission(config.Conf.Server.Port)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return newExitError(ExitConfigInvalid, err)
+	}
+
+	// 포그라운드 모드 체크 (systemd Type=simple, 컨테이너 등 감독 프로세스 하에서 실행 시 사용)
+	// 디버그 모드는 항상 포그라운드로 실행
+	debugMode := cmd.Use == "debug"
+	foreground, _ := cmd.Flags().GetBool("foreground")
+	foreground = foreground || debugMode
+
+	// 데몬 프로세스 생성 (자식 프로세스도 같은 설정 파일을 사용하도록 절대 경로 전달)
+	if !foreground {
+		var daemonArgs []string
+		if config.RunConf.ConfFilePath != "" {
+			daemonArgs = append(daemonArgs, "--config="+config.RunConf.ConfFilePath)
+		}
+		err = process.DaemonizeProcess(daemonArgs...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+			return err
+		}
 	}
 
 	// 현재 프로세스 PID 저장
 	config.RunConf.Pid = os.Getpid()
 
-	// 현재 프로세스 PID를 파일에 기록
-	err = file.WriteDataToTextFile(config.PidFilePath, config.RunConf.Pid, true)
+	// 디버그 모드 체크 (디버그 모드일 경우 stdout, stderr 출력)
+	// 데몬 프로세스의 stdout, stderr는 DaemonizeProcess에서 /dev/null로 연결됨
+	if debugMode {
+		config.RunConf.DebugMode = true
+	}
+
+	// 로거 초기화 (데몬화 이후 발생하는 에러를 로그에 기록하기 위해 가장 먼저 초기화)
+	err = logger.Log.InitializeLogger()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
 		return err
 	}
+	defer logger.Log.FinalizeLogger()
 
-	// 디버그 모드 체크 (디버그 모드일 경우 stdout, stderr 출력)
-	if cmd.Use == "debug" {
-		config.RunConf.DebugMode = true
+	// 로드한 설정 파일 경로 및 해시 로그 기록 (설정 파일 변조 확인용)
+	if confMissing {
+		logger.Log.LogWarn("Config file not found (path:%s), using defaults", confPath)
 	} else {
-		os.Stdout = nil
-		os.Stderr = nil
+		logger.Log.LogInfo("Config loaded (path:%s, sha256:%s)", confPath, o.confChecksum)
+	}
+
+	// 기본값으로 대체된 설정 값 로그 기록 (lenientValidation 모드)
+	for _, warning := range config.LoadWarnings() {
+		logger.Log.LogWarn("Invalid config value: %s", warning)
+	}
+
+	// PID 잠금 파일에 배타적 잠금 설정 (동시에 가동된 다른 프로세스가 있으면 종료)
+	// 잠금은 프로세스 종료 시까지 유지
+	pidLock, err := file.LockFile(config.PidLockFilePath)
+	if err != nil {
+		if errors.Is(err, file.ErrLocked) {
+			logger.Log.LogError("weblin is already running, exiting")
+			return newExitError(ExitAlreadyRunning, fmt.Errorf("already running"))
+		}
+		logger.Log.LogError("Failed to lock pid file, exiting: %v", err)
+		return err
+	}
+	defer file.UnlockFile(pidLock)
+
+	// 현재 프로세스 PID를 파일에 기록
+	// PID 파일이 없으면 stop 명령으로 종료할 수 없으므로 기록 실패 시 데몬을 종료
+	err = o.writePidFile(config.PidFilePath, config.RunConf.Pid)
+	if err != nil {
+		logger.Log.LogError("Failed to write pid file, exiting: %v", err)
+		return err
 	}
 
 	// 시그널 설정
@@ -112,8 +238,13 @@ func (o *operation) start(cmd *cobra.Command) error {
 	gm := goroutine.NewGoroutineManager()
 	// 패닉 핸들러 설정
 	gm.PanicHandler = o.panicHandler
+	gm.RestartHandler = o.restartHandler
 
-	o.initialization(gm)
+	err = o.initialization(gm)
+	if err != nil {
+		logger.Log.LogError("Failed to initialize: %v", err)
+		return err
+	}
 	defer o.finalization(gm)
 
 	logger.Log.LogInfo("Start %s (pid:%d, mode:%s)", config.ModuleName, config.RunConf.Pid,
@@ -123,17 +254,158 @@ func (o *operation) start(cmd *cobra.Command) error {
 			}
 			return "normal"
 		}())
+	// 실행 인자 및 환경 변수 로그 기록 (디버그 모드)
+	o.logRuntimeContext()
 
-	// 작업에 등록된 모든 고루틴 가동
-	gm.StartAll()
+	// 프로세스 타이틀 설정
+	o.setProcTitle()
 
-	// 종료 시그널 대기 (SIGINT, SIGTERM, SIGUSR1)
-	sig := <-sigChan
-	logger.Log.LogInfo("Received %s (signum:%d)", sig.String(), sig)
+	// 작업에 등록된 모든 고루틴 가동 (선행 작업 의존 관계 순서)
+	// 선행 작업 준비가 멈춰도 종료 시그널을 처리할 수 있도록 시그널 대기와 함께 진행
+	startCtx, cancelStart := context.WithCancel(context.Background())
+	defer cancelStart()
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- gm.StartAllContext(startCtx)
+	}()
+
+	// 시그널 대기 (SIGINT, SIGTERM, SIGUSR1: 종료, SIGHUP: 로그 파일 재오픈 및 설정 재로드)
+	for {
+		select {
+		case err := <-startErr:
+			startErr = nil
+			if err != nil {
+				logger.Log.LogError("Failed to start tasks: %v", err)
+				return err
+			}
+			continue
+		case sig := <-sigChan:
+			// SIGHUP은 종료하지 않고 로그 파일 재오픈 및 설정 재로드
+			if sig == syscall.SIGHUP {
+				o.hangup()
+				continue
+			}
+			logger.Log.LogInfo("Received %s (signum:%d)", sig.String(), sig)
+		}
+		break
+	}
+
+	// 가동이 끝나지 않았으면 중단하고 남은 작업이 가동되지 않도록 대기
+	if startErr != nil {
+		cancelStart()
+		if err := <-startErr; err != nil {
+			logger.Log.LogWarn("Task startup interrupted: %v", err)
+		}
+	}
 
 	return nil
 }
 
+// hangup SIGHUP 수신 시 처리
+//
+// 외부 logrotate가 로그 파일을 이동시킨 후 새 파일에 로그가 기록되도록 로그 파일을 다시 열고,
+// 설정 파일을 재로드하여 재시작 없이 적용 가능한 설정을 반영.
+// 샘플러가 건너뛰는 /proc 소스의 사용 가능 여부도 다시 확인
+func (o *operation) hangup() {
+	if err := logger.Log.Reopen(); err != nil {
+		logger.Log.LogError("Failed to reopen log file: %v", err)
+	} else {
+		logger.Log.LogInfo("Received SIGHUP, log file reopened")
+	}
+
+	o.reloadConfig()
+
+	// 컨테이너 권한 변경 등으로 읽을 수 있게 된 /proc 소스 재확인
+	if o.sampler != nil {
+		o.sampler.Recheck()
+	}
+}
+
+// reloadConfig 설정 파일 재로드
+//
+// 로그 파일 관리 설정, 로그 레벨, 접근 로그 형식, 느린 요청 기준 시간, 샘플링 주기, 디스크 측정 경로, 네트워크 제외 인터페이스만 적용하며,
+// 그 외 변경된 설정(리스닝 포트 등)은 재시작이 필요하므로 무시하고 로그로 기록.
+// 새 설정이 유효하지 않으면 현재 설정을 유지하며, 설정 파일 내용이 변경되지 않았으면 재로드를 생략.
+func (o *operation) reloadConfig() {
+	// 설정 파일 해시가 마지막으로 로드한 해시와 같으면 재로드 생략
+	// (해시 계산에 실패하면 재로드하여 에러를 기록)
+	checksum, err := file.FileSHA256(config.RunConf.ConfPath())
+	if err == nil && checksum == o.confChecksum {
+		logger.Log.LogInfo("Config reloaded (file unchanged)")
+		return
+	}
+
+	newConf := config.DefaultConfig()
+	if err := newConf.LoadConfig(config.RunConf.ConfPath()); err != nil {
+		logger.Log.LogError("Failed to reload config, keeping current config: %v", err)
+		return
+	}
+	o.confChecksum = checksum
+	for _, warning := range config.LoadWarnings() {
+		logger.Log.LogWarn("Invalid config value: %s", warning)
+	}
+
+	// 재시작 없이 적용 가능한 설정 항목
+	reloadable := map[string]bool{
+		"log.maxLogFileSize":         true,
+		"log.maxLogFileBackup":       true,
+		"log.maxLogFileAge":          true,
+		"log.compressBackupLogFile":  true,
+		"log.level":                  true,
+		"log.structuredAccessLog":    true,
+		"log.slowRequestThresholdMs": true,
+	}
+	// 샘플러 관련 설정은 백그라운드 샘플러가 동작 중일 때만 적용 가능
+	if o.sampler != nil {
+		reloadable["metric.sampleIntervalSec"] = true
+		reloadable["metric.diskPaths"] = true
+		reloadable["metric.excludeInterfaces"] = true
+	}
+
+	changed := config.Diff(o.appliedConf, newConf)
+	if len(changed) == 0 {
+		logger.Log.LogInfo("Config reloaded (no changes)")
+		return
+	}
+
+	var applied []string
+	for _, path := range changed {
+		if reloadable[path] {
+			applied = append(applied, path)
+			continue
+		}
+		logger.Log.LogWarn("Config %s changed, ignored on reload (restart required)", path)
+	}
+	if len(applied) == 0 {
+		return
+	}
+
+	// 로그 설정 적용 (처리 중인 요청과 경합하지 않도록 config.Conf는 변경하지 않고 각 소유자에게 전달)
+	o.appliedConf.Log.MaxLogFileSize = newConf.Log.MaxLogFileSize
+	o.appliedConf.Log.MaxLogFileBackup = newConf.Log.MaxLogFileBackup
+	o.appliedConf.Log.MaxLogFileAge = newConf.Log.MaxLogFileAge
+	o.appliedConf.Log.CompBakLogFile = newConf.Log.CompBakLogFile
+	o.appliedConf.Log.Level = newConf.Log.Level
+	o.appliedConf.Log.StructuredAccessLog = newConf.Log.StructuredAccessLog
+	o.appliedConf.Log.SlowRequestThresholdMs = newConf.Log.SlowRequestThresholdMs
+	logger.Log.Reconfigure(&o.appliedConf)
+	server.SetAccessLogOptions(server.AccessLogOptions{
+		Structured:    o.appliedConf.Log.StructuredAccessLog,
+		SlowThreshold: time.Duration(o.appliedConf.Log.SlowRequestThresholdMs) * time.Millisecond,
+	})
+
+	// 샘플러 설정 적용
+	if o.sampler != nil {
+		o.appliedConf.Metric.SampleIntervalSec = newConf.Metric.SampleIntervalSec
+		o.appliedConf.Metric.DiskPaths = newConf.Metric.DiskPaths
+		o.appliedConf.Metric.ExcludeInterfaces = newConf.Metric.ExcludeInterfaces
+		o.sampler.SetInterval(time.Duration(o.appliedConf.Metric.SampleIntervalSec) * time.Second)
+		o.sampler.Collector.SetTargets(o.appliedConf.Metric.DiskPaths, o.appliedConf.Metric.ExcludeInterfaces)
+	}
+
+	logger.Log.LogInfo("Config reloaded (applied: %s)", strings.Join(applied, ", "))
+}
+
 // stop weblin 모듈 정지
 //
 // Parameters:
@@ -152,7 +424,8 @@ func (o *operation) stop(cmd *cobra.Command) error {
 	// 프로세스가 동작 중인지 확인
 	var pid int
 	if !o.isRunning(&pid, config.PidFilePath) {
-		return nil
+		fmt.Fprintf(os.Stdout, "[INFO] weblin is not running.\n")
+		return newExitError(ExitNotRunning, fmt.Errorf("not running"))
 	}
 
 	// 서버에 정지 시그널 전송 (SIGTERM)
@@ -164,18 +437,243 @@ func (o *operation) stop(cmd *cobra.Command) error {
 	return nil
 }
 
+// reload weblin 모듈 설정 재로드
+//
+// 동작 중인 프로세스에 SIGHUP을 전송하여 로그 파일 재오픈 및 설정 재로드 요청
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (o *operation) reload(cmd *cobra.Command) error {
+	// 작업 경로를 현재 프로세스가 위치한 경로로 변경
+	err := o.changeWorkPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 프로세스가 동작 중인지 확인
+	var pid int
+	if !o.isRunning(&pid, config.PidFilePath) {
+		fmt.Fprintf(os.Stdout, "[INFO] weblin is not running.\n")
+		return newExitError(ExitNotRunning, fmt.Errorf("not running"))
+	}
+
+	// 서버에 재로드 시그널 전송 (SIGHUP)
+	if err := process.SendSignal(pid, syscall.SIGHUP); err != nil {
+		fmt.Fprintf(os.Stderr, "[WARNING] %v\n", err)
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "[INFO] Sent reload signal to weblin. (pid:%d)\n", pid)
+
+	return nil
+}
+
+// status weblin 모듈 동작 상태 출력
+//
+// 동작 중이면 PID, 가동 시간, 리스닝 포트, TLS 사용 여부를 출력하며,
+// 모니터링 스크립트에서 사용할 수 있도록 LSB 규약에 따라 미동작 시 종료 코드 3 반환
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//
+// Returns:
+//   - error: 동작 중(nil), 미동작 또는 실패(error)
+func (o *operation) status(cmd *cobra.Command) error {
+	// --config 플래그로 지정된 상대 경로를 현재 경로 기준 절대 경로로 변환
+	err := o.resolveConfPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 작업 경로를 실행 파일이 위치한 경로로 변경
+	err = o.changeWorkPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 프로세스가 동작 중인지 확인
+	var pid int
+	if !o.isRunning(&pid, config.PidFilePath) {
+		fmt.Fprintf(os.Stdout, "[INFO] weblin is not running.\n")
+		return newExitError(ExitNotRunning, fmt.Errorf("not running"))
+	}
+
+	fmt.Fprintf(os.Stdout, "[INFO] weblin is running.\n")
+	fmt.Fprintf(os.Stdout, "  PID    : %d\n", pid)
+
+	uptime, err := resource.GetProcessUptime(pid)
+	if err != nil {
+		fmt.Fprintf(os.Stdout, "  Uptime : unknown (%v)\n", err)
+	} else {
+		fmt.Fprintf(os.Stdout, "  Uptime : %s\n", uptime.Truncate(time.Second))
+	}
+
+	// 리스닝 포트 및 TLS 사용 여부는 설정 파일 기준으로 출력
+	err = config.Conf.LoadConfig(config.RunConf.ConfPath())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[WARNING] Failed to load config: %v\n", err)
+		return nil
+	}
+	fmt.Fprintf(os.Stdout, "  Port   : %d\n", config.Conf.Server.Port)
+	fmt.Fprintf(os.Stdout, "  TLS    : %s\n", func() string {
+		if config.Conf.Server.TLS.Enabled {
+			return "on"
+		}
+		return "off"
+	}())
+
+	return nil
+}
+
+// restart weblin 모듈 재가동
+//
+// 동작 중인 프로세스에 정지 시그널을 전송하고 종료될 때까지 대기한 후 가동.
+// 제한 시간 내에 종료되지 않으면 중복 가동하지 않고 에러 반환.
+//
+// Parameters:
+//   - cmd: cobra 명령어 정보 구조체
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (o *operation) restart(cmd *cobra.Command) error {
+	// --config 플래그로 지정된 상대 경로를 현재 경로 기준 절대 경로로 변환
+	err := o.resolveConfPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 작업 경로를 실행 파일이 위치한 경로로 변경
+	err = o.changeWorkPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+		return err
+	}
+
+	// 동작 중인 프로세스가 있으면 정지 후 종료 대기
+	var pid int
+	if o.isRunning(&pid, config.PidFilePath) {
+		err = o.stop(cmd)
+		if err != nil {
+			return err
+		}
+
+		timeoutSec, _ := cmd.Flags().GetInt("timeout")
+		if !o.waitForExit(pid, time.Duration(timeoutSec)*time.Second) {
+			err = fmt.Errorf("weblin did not exit within %ds (pid:%d)", timeoutSec, pid)
+			fmt.Fprintf(os.Stderr, "[ERROR] %v\n", err)
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "[INFO] weblin stopped. (pid:%d)\n", pid)
+	}
+
+	return o.start(cmd)
+}
+
+// waitForExit 프로세스가 종료될 때까지 대기
+//
+// Parameters:
+//   - pid: 대기할 프로세스 PID
+//   - timeout: 최대 대기 시간
+//
+// Returns:
+//   - bool: 종료됨(true), 제한 시간 초과(false)
+func (o *operation) waitForExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for process.IsProcessRun(pid) {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(exitPollInterval)
+	}
+	return true
+}
+
 // initialization 모듈 초기화
 //
 // Parameters:
 //   - gm: 고루틴 동작 관리 구조체
-func (o *operation) initialization(gm *goroutine.GoroutineManager) {
-	// 설정 파일 로드
-	config.Conf.LoadConfig(config.ConfFilePath)
-	// 로거 초기화
-	logger.Log.InitializeLogger()
-
-	var server server.Server
-	gm.AddTask("server", server.Run)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (o *operation) initialization(gm *goroutine.GoroutineManager) error {
+	// Go 런타임 소프트 메모리 제한 설정
+	o.setMemoryLimit()
+
+	// 리소스 사용률 최대값 추적 구간 설정
+	resource.ConfigurePeaks(time.Duration(config.Conf.Metric.PeakWindowSec)*time.Second,
+		config.Conf.Metric.PeakRolling)
+
+	serv := server.Server{Tasks: gm}
+
+	// 백그라운드 리소스 사용률 샘플링 작업 등록 (스크래핑 시점 계산 모드는 제외)
+	if !config.Conf.Metric.CollectOnScrape {
+		sampler := resource.NewSampler(
+			time.Duration(config.Conf.Metric.SampleIntervalSec)*time.Second,
+			&resource.UsageCollector{
+				DiskPaths:         config.Conf.Metric.DiskPaths,
+				ExcludeInterfaces: config.Conf.Metric.ExcludeInterfaces,
+			},
+		)
+		sampler.OnError = func(err error) {
+			logger.Log.LogDebug("Failed to collect resource usage: %v", err)
+		}
+		sampler.OnSourceChange = func(source string, err error) {
+			if err != nil {
+				logger.Log.LogWarn("Resource source unavailable, skipping %s usage until SIGHUP: %v", source, err)
+				return
+			}
+			logger.Log.LogInfo("Resource source available again, collecting %s usage", source)
+		}
+		// 일시적인 /proc 읽기 문제로 모니터링이 중단되지 않도록 패닉 발생 시 재시작
+		gm.AddTaskWithOptions("sampler", sampler.Run, goroutine.TaskOptions{
+			RestartOnPanic: true,
+			MaxRestarts:    samplerMaxRestarts,
+			RestartBackoff: time.Second,
+			SignalsReady:   true,
+		})
+		o.sampler = sampler
+		serv.Sampler = sampler
+
+		// 샘플링된 리소스 사용률을 WebSocket 클라이언트에 전송하는 작업 등록
+		if config.Conf.API.WSMaxClients > 0 {
+			hub := server.NewStatsHub(config.Conf.API.WSMaxClients,
+				config.Conf.API.WSSendBuffer, config.Conf.API.WSSlowClientPolicy)
+			sampler.OnSample = hub.Publish
+			gm.AddTask("wsstats", hub.Run)
+			serv.StatsHub = hub
+		}
+	}
+	// 리소스 사용률 기준 스냅샷 측정 후 서버 가동
+	var serverDeps []string
+	if o.sampler != nil {
+		serverDeps = append(serverDeps, "sampler")
+	}
+	gm.AddTaskWithDeps("server", serverDeps, serv.Run)
+
+	// systemd 워치독이 활성화되어 있으면 keep-alive 전송 작업 등록
+	if _, ok := systemd.WatchdogInterval(); ok {
+		gm.AddTask("watchdog", o.watchdog)
+	}
+
+	// 디버그 모드에서 힙 메모리 통계 로그 출력 작업 등록
+	if config.RunConf.DebugMode && config.Conf.Process.MemReportIntervalSec > 0 {
+		gm.AddTask("memreport", o.memReport)
+	}
+
+	// 초기화 완료 (샘플러 사용 시 첫 샘플링 성공 후 준비 상태로 응답)
+	var readyCheck func() bool
+	if o.sampler != nil {
+		readyCheck = o.sampler.Sampled
+	}
+	server.SetReady(readyCheck)
+
+	return nil
 }
 
 // finalization 모듈 종료 시 자원 정리
@@ -183,11 +681,240 @@ func (o *operation) initialization(gm *goroutine.GoroutineManager) {
 // Parameters:
 //   - gm: 고루틴 동작 관리 구조체
 func (o *operation) finalization(gm *goroutine.GoroutineManager) {
-	// 작업에 등록된 모든 고루틴 종료
-	gm.StopAll(10 * time.Second)
+	// systemd에 서비스 종료 시작 알림
+	systemd.Notify(systemd.NotifyStopping)
+
+	// 작업에 등록된 모든 고루틴 종료 (서버의 종료 전 대기 및 graceful shutdown 시간 보장)
+	timeout := 10 * time.Second
+	serverTimeout := time.Duration(config.Conf.Server.PreShutdownDelaySec+
+		config.Conf.Server.ShutdownTimeoutSec)*time.Second + 5*time.Second
+	gm.StopAll(max(timeout, serverTimeout))
+}
+
+// checkPortPermission 현재 프로세스가 리스닝 포트에 바인딩할 수 있는 권한이 있는지 확인
+//
+// 1024 미만 포트는 root 또는 CAP_NET_BIND_SERVICE 권한이 필요하며,
+// 권한이 없으면 데몬화 이후 알기 어려운 바인딩 에러가 발생하므로 미리 확인
+//
+// Parameters:
+//   - port: 리스닝 포트
+//
+// Returns:
+//   - error: 바인딩 가능(nil), 바인딩 불가(error)
+func (o *operation) checkPortPermission(port int) error {
+	// 비특권 포트 시작 번호 획득 (컨테이너 등에서 낮춰져 있을 수 있음)
+	unprivPortStart := 1024
+	data, err := os.ReadFile("/proc/sys/net/ipv4/ip_unprivileged_port_start")
+	if err == nil {
+		if v, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil {
+			unprivPortStart = v
+		}
+	}
+
+	if port >= unprivPortStart || os.Geteuid() == 0 {
+		return nil
+	}
+
+	hasCap, err := process.HasCapability(process.CapNetBindService)
+	if err == nil && hasCap {
+		return nil
+	}
+
+	return fmt.Errorf("port %d is privileged and weblin is not running as root "+
+		"(use a port >= %d, or grant the capability: setcap 'cap_net_bind_service=+ep' <weblin binary>)",
+		port, unprivPortStart)
+}
+
+// setMemoryLimit Go 런타임 소프트 메모리 제한 설정
+//
+// GOMEMLIMIT 환경 변수가 설정되어 있으면 환경 변수 값을 그대로 사용
+func (o *operation) setMemoryLimit() {
+	if env := os.Getenv("GOMEMLIMIT"); env != "" {
+		logger.Log.LogInfo("Go memory limit: %s (source: GOMEMLIMIT)", env)
+		return
+	}
+
+	var limit int64
+	var source string
+
+	if config.Conf.Process.MemoryLimitMB > 0 {
+		limit = int64(config.Conf.Process.MemoryLimitMB) << 20
+		source = "config"
+	} else if config.Conf.Process.MemoryLimitCgroupPercent > 0 {
+		cgroupLimit, err := resource.GetCgroupMemoryLimit()
+		if err != nil {
+			logger.Log.LogWarn("Failed to get cgroup memory limit: %v", err)
+			return
+		}
+		if cgroupLimit == 0 {
+			logger.Log.LogInfo("Go memory limit not set (cgroup has no memory limit)")
+			return
+		}
+		limit = int64(cgroupLimit / 100 * uint64(config.Conf.Process.MemoryLimitCgroupPercent))
+		source = fmt.Sprintf("%d%% of cgroup limit %dMB",
+			config.Conf.Process.MemoryLimitCgroupPercent, cgroupLimit>>20)
+	} else {
+		return
+	}
+
+	debug.SetMemoryLimit(limit)
+	logger.Log.LogInfo("Go memory limit: %dMB (source: %s)", debug.SetMemoryLimit(-1)>>20, source)
+}
+
+// watchdog systemd 워치독 keep-alive를 주기적으로 전송
+//
+// 워치독 타임아웃의 절반 주기로 전송하여 타임아웃 전에 갱신되도록 함
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+func (o *operation) watchdog(ctx context.Context) {
+	timeout, ok := systemd.WatchdogInterval()
+	if !ok {
+		return
+	}
+
+	ticker := time.NewTicker(timeout / 2)
+	defer ticker.Stop()
+
+	logger.Log.LogInfo("systemd watchdog enabled (timeout: %v)", timeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := systemd.Notify(systemd.NotifyWatchdog); err != nil {
+				logger.Log.LogWarn("Failed to send watchdog keep-alive: %v", err)
+			}
+		}
+	}
+}
+
+// memReport 힙 메모리 통계를 주기적으로 로그에 기록
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+func (o *operation) memReport(ctx context.Context) {
+	interval := time.Duration(config.Conf.Process.MemReportIntervalSec) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if config.Conf.Process.MemReportForceGC {
+				runtime.GC()
+			}
+
+			var ms runtime.MemStats
+			runtime.ReadMemStats(&ms)
+			logger.Log.LogDebug("Heap stats (alloc: %dKB, inuse: %dKB, idle: %dKB, sys: %dKB, "+
+				"objects: %d, numGC: %d, goroutines: %d)",
+				ms.HeapAlloc>>10, ms.HeapInuse>>10, ms.HeapIdle>>10, ms.Sys>>10,
+				ms.HeapObjects, ms.NumGC, runtime.NumGoroutine())
+		}
+	}
+}
+
+// logRuntimeContext 실행 인자 및 WEBLIN_ 환경 변수를 디버그 로그로 기록
+//
+// 운영 로그에 환경 변수가 남지 않도록 디버그 모드에서만 기록하며,
+// 민감한 정보가 포함될 수 있는 환경 변수 값은 마스킹
+func (o *operation) logRuntimeContext() {
+	if !config.RunConf.DebugMode {
+		return
+	}
+
+	logger.Log.LogDebug("Command line: %q", os.Args)
+
+	// 값을 마스킹할 환경 변수명 키워드
+	sensitive := []string{"PASS", "SECRET", "TOKEN", "KEY", "CREDENTIAL", "AUTH"}
+
+	var envs []string
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, "WEBLIN_") {
+			continue
+		}
+		for _, keyword := range sensitive {
+			if strings.Contains(strings.ToUpper(name), keyword) {
+				value = "[REDACTED]"
+				break
+			}
+		}
+		envs = append(envs, name+"="+value)
+	}
+	sort.Strings(envs)
+
+	logger.Log.LogDebug("Environment: %q", envs)
+}
+
+// resolveConfPath --config 플래그로 지정된 설정 파일 경로를 절대 경로로 변환
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (o *operation) resolveConfPath() error {
+	if config.RunConf.ConfFilePath == "" {
+		return nil
+	}
+
+	absPath, err := filepath.Abs(config.RunConf.ConfFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve config path: %v", err)
+	}
+	config.RunConf.ConfFilePath = absPath
+
+	return nil
+}
+
+// setProcTitle 설정 파일의 템플릿으로 프로세스 타이틀 설정
+func (o *operation) setProcTitle() {
+	if config.Conf.Process.Title == "" {
+		return
+	}
+
+	mode := "normal"
+	if config.RunConf.DebugMode {
+		mode = "debug"
+	}
+	confPath := config.RunConf.ConfPath()
+	confName := strings.TrimSuffix(filepath.Base(confPath), filepath.Ext(confPath))
+
+	title := strings.NewReplacer(
+		"{name}", config.ModuleName,
+		"{config}", confName,
+		"{port}", strconv.Itoa(config.Conf.Server.Port),
+		"{mode}", mode,
+		"{pid}", strconv.Itoa(config.RunConf.Pid),
+	).Replace(config.Conf.Process.Title)
+
+	err := process.SetProcTitle(title)
+	if err != nil {
+		logger.Log.LogWarn("Failed to set process title: %v", err)
+		return
+	}
+	logger.Log.LogDebug("Process title set (%s)", title)
+}
+
+// writePidFile PID 파일 기록
+//
+// Parameters:
+//   - pidFilePath: PID 파일 경로
+//   - pid: PID
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (o *operation) writePidFile(pidFilePath string, pid int) error {
+	// PID 파일 디렉터리 생성 (rwxr-xr-x)
+	err := file.EnsureDir(filepath.Dir(pidFilePath), file.DefaultDirPerm)
+	if err != nil {
+		return fmt.Errorf("failed to make pid directory: %v", err)
+	}
 
-	// 로그 자원 정리
-	logger.Log.FinalizeLogger()
+	// stop 등에서 비어 있거나 일부만 기록된 PID를 읽지 않도록 원자적으로 기록
+	return file.AtomicWriteFile(pidFilePath, []byte(strconv.Itoa(pid)), 0644)
 }
 
 // changeWorkPath 프로세스 작업 경로를 실행 파일이 위치한 경로로 변경
@@ -246,16 +973,60 @@ func (o *operation) isRunning(pid *int, pidFilePath string) bool {
 
 // setupSignal 시그널 설정
 //
+// 기본 시그널 처리 동작에 설정 파일의 시그널 처리 설정을 덮어써서 적용
+//
 // Returns:
 //   - chan os.Signal: signal channel
 func (o *operation) setupSignal() chan os.Signal {
+	// 기본 시그널 처리 동작
+	actions := map[syscall.Signal]string{
+		syscall.SIGINT:    config.SignalHandle,
+		syscall.SIGTERM:   config.SignalHandle,
+		syscall.SIGHUP:    config.SignalHandle,
+		syscall.SIGABRT:   config.SignalIgnore,
+		syscall.SIGALRM:   config.SignalIgnore,
+		syscall.SIGFPE:    config.SignalIgnore,
+		syscall.SIGILL:    config.SignalIgnore,
+		syscall.SIGPROF:   config.SignalIgnore,
+		syscall.SIGQUIT:   config.SignalIgnore,
+		syscall.SIGTSTP:   config.SignalIgnore,
+		syscall.SIGVTALRM: config.SignalIgnore,
+	}
+
+	// 설정 파일의 시그널 처리 설정 적용 (LoadConfig에서 유효성 검사 완료)
+	for name, action := range config.Conf.Signal {
+		sig, err := process.ParseSignal(name)
+		if err != nil {
+			continue
+		}
+		actions[sig] = action
+	}
+
+	// SIGUSR1은 내부 에러 발생 시 종료 신호로 사용하므로 항상 수신
+	handleSigs := []os.Signal{syscall.SIGUSR1}
+	var ignoreSigs, defaultSigs []os.Signal
+	for sig, action := range actions {
+		switch action {
+		case config.SignalHandle:
+			handleSigs = append(handleSigs, sig)
+		case config.SignalIgnore:
+			ignoreSigs = append(ignoreSigs, sig)
+		case config.SignalDefault:
+			defaultSigs = append(defaultSigs, sig)
+		}
+	}
+
 	sigChan := make(chan os.Signal, 1)
-	// 수신할 시그널 설정 (SIGINT, SIGTERM, SIGUSR1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR1)
+	// 수신할 시그널 설정
+	signal.Notify(sigChan, handleSigs...)
 	// 무시할 시그널 설정
-	signal.Ignore(syscall.SIGABRT, syscall.SIGALRM, syscall.SIGFPE, syscall.SIGHUP,
-		syscall.SIGILL, syscall.SIGPROF, syscall.SIGQUIT, syscall.SIGTSTP,
-		syscall.SIGVTALRM)
+	if len(ignoreSigs) > 0 {
+		signal.Ignore(ignoreSigs...)
+	}
+	// 기본 동작을 유지할 시그널 설정
+	if len(defaultSigs) > 0 {
+		signal.Reset(defaultSigs...)
+	}
 
 	return sigChan
 }
@@ -268,3 +1039,13 @@ func (o *operation) panicHandler(panicErr interface{}) {
 	logger.Log.LogError("Panic occurred: %v", panicErr)
 	process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
 }
+
+// restartHandler 패닉 발생 작업 재시작 핸들러
+//
+// Parameters:
+//   - name: 작업명
+//   - panicErr: 패닉 에러
+//   - restart: 재시작 횟수
+func (o *operation) restartHandler(name string, panicErr interface{}, restart int) {
+	logger.Log.LogError("Panic occurred in %s, restarting (restart: %d): %v", name, restart, panicErr)
+}
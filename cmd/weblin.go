@@ -20,6 +20,7 @@ Package cmd CLI 패키지
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -45,11 +46,94 @@ Weblin provides a service that allows you to easily manage multiple Linux server
 	Version: config.Version + "\nBuild Date: " + config.BuildTime,
 }
 
+// CLI 종료 코드
+const (
+	// 정상 종료
+	ExitSuccess = 0
+	// 일반 에러
+	ExitError = 1
+	// 잘못된 명령어 사용 (알 수 없는 명령어, 플래그 에러 등)
+	ExitUsage = 2
+	// weblin이 동작 중이지 않음
+	ExitNotRunning = 3
+	// weblin이 이미 동작 중임
+	ExitAlreadyRunning = 4
+	// 설정 파일 에러
+	ExitConfigInvalid = 5
+)
+
+// exitError 종료 코드를 포함하는 에러 구조체
+type exitError struct {
+	code int
+	err  error
+}
+
+// Error error 인터페이스 구현
+//
+// Returns:
+//   - string: 에러 메시지
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap 래핑된 에러 반환
+//
+// Returns:
+//   - error: 래핑된 에러
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// newExitError 종료 코드를 포함하는 에러 생성
+//
+// Parameters:
+//   - code: 종료 코드
+//   - err: 에러
+//
+// Returns:
+//   - error: 종료 코드를 포함하는 에러
+func newExitError(code int, err error) error {
+	return &exitError{code: code, err: err}
+}
+
+// exitCode 에러로부터 종료 코드 추출
+//
+// Parameters:
+//   - err: cobra 명령어 실행 결과 에러
+//
+// Returns:
+//   - int: 종료 코드
+func exitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+
+	var exitErr *exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+
+	// 명령어 함수에서 반환된 에러는 모두 exitError로 래핑되므로,
+	// 그 외의 에러는 cobra의 인자/플래그 파싱 에러임
+	return ExitUsage
+}
+
 // init 패키지 임포트 시 초기화
 func init() {
 	weblinCmd.AddCommand(startCmd)
 	weblinCmd.AddCommand(debugCmd)
 	weblinCmd.AddCommand(stopCmd)
+	weblinCmd.AddCommand(restartCmd)
+	weblinCmd.AddCommand(statusCmd)
+	weblinCmd.AddCommand(reloadCmd)
+	weblinCmd.AddCommand(configCmd)
+
+	startCmd.Flags().BoolP("foreground", "f", false, "run in the foreground without daemonizing")
+	restartCmd.Flags().BoolP("foreground", "f", false, "run in the foreground without daemonizing")
+	restartCmd.Flags().Int("timeout", 30, "seconds to wait for the running weblin to exit")
+
+	weblinCmd.PersistentFlags().StringVar(&config.RunConf.ConfFilePath, "config", "",
+		"config file path (default: "+config.ConfFilePath+" under the executable directory)")
 }
 
 // Execute CLI 처리
@@ -63,7 +147,7 @@ func Execute() {
 
 	err = weblinCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 }
 
@@ -72,6 +156,15 @@ func Execute() {
 // 이 함수는 `func(cmd *cobra.Command) error` 형태의 함수를 받아서,
 // cobra의 `RunE` 메서드에서 요구하는 `func(cmd *cobra.Command, _ []string) error` 형태로 변환.
 //
+// 명령어 함수가 반환한 에러는 아래의 종료 코드로 변환되어 프로세스 종료 시 사용됨.
+// newExitError로 종료 코드를 지정하지 않은 에러는 ExitError(1)로 처리됨.
+//   - 0 (ExitSuccess): 정상 종료
+//   - 1 (ExitError): 일반 에러
+//   - 2 (ExitUsage): 잘못된 명령어 사용 (cobra 인자/플래그 파싱 에러)
+//   - 3 (ExitNotRunning): weblin이 동작 중이지 않음
+//   - 4 (ExitAlreadyRunning): weblin이 이미 동작 중임
+//   - 5 (ExitConfigInvalid): 설정 파일 에러
+//
 // Parameters:
 //   - f: `func(cmd *cobra.Command) error` 형태의 함수로, cobra 명령어의 실행 로직을 포함
 //
@@ -79,8 +172,20 @@ func Execute() {
 //   - func: `func(cmd *cobra.Command, _ []string) error` 형태의 함수로 변환된 결과
 func WrapCmdFuncForCobra(f func(cmd *cobra.Command) error) func(cmd *cobra.Command, _ []string) error {
 	return func(cmd *cobra.Command, _ []string) error {
-		// cobra에서 출력하는 에러 메시지 무시
+		// cobra에서 출력하는 에러 메시지 및 사용법 무시
 		cmd.SilenceErrors = true
-		return f(cmd)
+		cmd.SilenceUsage = true
+
+		err := f(cmd)
+		if err == nil {
+			return nil
+		}
+
+		// 종료 코드가 지정되지 않은 에러는 일반 에러로 처리
+		var exitErr *exitError
+		if !errors.As(err, &exitErr) {
+			return newExitError(ExitError, err)
+		}
+		return err
 	}
 }
@@ -20,9 +20,17 @@ Package config 설정 패키지
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/pelletier/go-toml/v2"
 	"gopkg.in/yaml.v3"
 )
 
@@ -37,6 +45,8 @@ const (
 	PidFilePath  = "var/.weblin.pid"
 	LogFilePath  = "log/weblin.log"
 	ConfFilePath = "conf/weblin.yaml"
+	// PID 파일은 원자적으로 교체(rename)되므로 잠금은 별도 파일에 설정
+	PidLockFilePath = "var/.weblin.pid.lock"
 )
 
 // Config 설정 정보 구조체
@@ -44,102 +54,395 @@ type Config struct {
 	// 서버 설정
 	Server struct {
 		// 서버 리스닝 포트 (DEF:8443)
-		Port int `yaml:"port"`
+		Port int `yaml:"port" toml:"port" json:"port" validate:"min=1,max=65535"`
+		// 서버 리스닝 호스트 주소 (DEF:"", 빈 문자열이면 모든 인터페이스)
+		BindAddr string `yaml:"bindAddr" toml:"bindAddr" json:"bindAddr"`
+		// 요청 읽기 타임아웃 (초 단위, DEF:10)
+		ReadTimeoutSec int `yaml:"readTimeoutSec" toml:"readTimeoutSec" json:"readTimeoutSec" validate:"min=1,max=3600"`
+		// 응답 쓰기 타임아웃 (초 단위, DEF:10)
+		WriteTimeoutSec int `yaml:"writeTimeoutSec" toml:"writeTimeoutSec" json:"writeTimeoutSec" validate:"min=1,max=3600"`
+		// keep-alive 유휴 연결 타임아웃 (초 단위, DEF:0, 0이면 readTimeoutSec 사용)
+		IdleTimeoutSec int `yaml:"idleTimeoutSec" toml:"idleTimeoutSec" json:"idleTimeoutSec" validate:"min=0,max=3600"`
+		// graceful shutdown 최대 대기 시간 (초 단위, DEF:5)
+		ShutdownTimeoutSec int `yaml:"shutdownTimeoutSec" toml:"shutdownTimeoutSec" json:"shutdownTimeoutSec" validate:"min=1,max=3600"`
+		// 종료 시 준비 상태 해제 후 graceful shutdown 시작 전 대기 시간 (초 단위, DEF:0)
+		PreShutdownDelaySec int `yaml:"preShutdownDelaySec" toml:"preShutdownDelaySec" json:"preShutdownDelaySec" validate:"min=0,max=300"`
 		// TLS 설정
-		TLS TLSYaml `yaml:"tls"`
-	} `yaml:"server"`
+		TLS TLSYaml `yaml:"tls" toml:"tls" json:"tls"`
+	} `yaml:"server" toml:"server" json:"server"`
 
 	// API 설정
 	API struct {
 		// 서버 메트릭을 제공하는 엔드포인트 (DEF:/metrics)
-		MetricURI string `yaml:"metricURI"`
+		MetricURI string `yaml:"metricURI" toml:"metricURI" json:"metricURI"`
 		// 서버 상태 점검을 위한 엔드포인트 (DEF:/health)
-		HealthURI string `yaml:"healthURI"`
+		HealthURI string `yaml:"healthURI" toml:"healthURI" json:"healthURI"`
+		// 서버 준비 상태 점검을 위한 엔드포인트 (DEF:/ready)
+		ReadyURI string `yaml:"readyURI" toml:"readyURI" json:"readyURI"`
 		// 서버 상태 정보를 제공하는 엔드포인트 (DEF:/sys/stats)
-		SysStatURI string `yaml:"sysStatURI"`
-	} `yaml:"api"`
+		SysStatURI string `yaml:"sysStatURI" toml:"sysStatURI" json:"sysStatURI"`
+		// 서버 상태 정보를 초기화하는 엔드포인트 (DEF:/sys/stats/reset, POST)
+		SysStatResetURI string `yaml:"sysStatResetURI" toml:"sysStatResetURI" json:"sysStatResetURI"`
+		// 리소스 사용률을 실시간으로 전송하는 WebSocket 엔드포인트 (DEF:/ws/stats)
+		WSStatsURI string `yaml:"wsStatsURI" toml:"wsStatsURI" json:"wsStatsURI"`
+		// WebSocket 최대 동시 접속 클라이언트 수 (DEF:16, 0이면 WebSocket 엔드포인트 비활성화)
+		WSMaxClients int `yaml:"wsMaxClients" toml:"wsMaxClients" json:"wsMaxClients" validate:"min=0,max=10000"`
+		// WebSocket 클라이언트별 전송 대기 메시지 수 (DEF:4)
+		WSSendBuffer int `yaml:"wsSendBuffer" toml:"wsSendBuffer" json:"wsSendBuffer" validate:"min=1,max=1024"`
+		// 전송 대기 버퍼가 가득 찬 느린 클라이언트 처리 방식 (DEF:drop)
+		// drop: 새 메시지를 버림, disconnect: 연결 종료
+		WSSlowClientPolicy string `yaml:"wsSlowClientPolicy" toml:"wsSlowClientPolicy" json:"wsSlowClientPolicy" validate:"oneof=drop disconnect"`
+		// CORS 설정
+		CORS CORSYaml `yaml:"cors" toml:"cors" json:"cors"`
+		// HTTP 기본 인증 설정
+		BasicAuth BasicAuthYaml `yaml:"basicAuth" toml:"basicAuth" json:"basicAuth"`
+		// 클라이언트 IP별 초당 허용 요청 수 (DEF:0, 0이면 요청 수 제한 비활성화)
+		RateLimitPerSec float64 `yaml:"rateLimitPerSec" toml:"rateLimitPerSec" json:"rateLimitPerSec" validate:"min=0,max=100000"`
+		// 클라이언트 IP별 순간 최대 허용 요청 수 (DEF:20)
+		RateLimitBurst int `yaml:"rateLimitBurst" toml:"rateLimitBurst" json:"rateLimitBurst" validate:"min=1,max=100000"`
+		// gzip 응답 압축 사용 (DEF:false)
+		GzipEnabled bool `yaml:"gzipEnabled" toml:"gzipEnabled" json:"gzipEnabled"`
+		// gzip 압축 최소 응답 크기 (바이트 단위, DEF:1024)
+		GzipMinSize int `yaml:"gzipMinSize" toml:"gzipMinSize" json:"gzipMinSize" validate:"min=0,max=10485760"`
+		// /debug/pprof 프로파일링 엔드포인트 사용 (DEF:false, 디버그 모드에서는 항상 사용)
+		PprofEnabled bool `yaml:"pprofEnabled" toml:"pprofEnabled" json:"pprofEnabled"`
+		// 클라이언트 IP 헤더(X-Forwarded-For 등)를 신뢰할 프록시 IP/CIDR 리스트 (DEF:[], 비어 있으면 gin 기본 동작)
+		TrustedProxies []string `yaml:"trustedProxies" toml:"trustedProxies" json:"trustedProxies" validate:"dive,cidr|ip"`
+		// 접근 로그에서 제외할 추가 경로 리스트 (DEF:[], 메트릭/헬스/준비 상태 엔드포인트는 항상 제외)
+		AccessLogExcludePaths []string `yaml:"accessLogExcludePaths" toml:"accessLogExcludePaths" json:"accessLogExcludePaths"`
+	} `yaml:"api" toml:"api" json:"api"`
 
 	// 로그 설정
 	Log struct {
 		// 최대 로그 파일 사이즈 (DEF:100MB, MIN:1MB, MAX:1000MB)
-		MaxLogFileSize int `yaml:"maxLogFileSize"`
+		MaxLogFileSize int `yaml:"maxLogFileSize" toml:"maxLogFileSize" json:"maxLogFileSize" validate:"min=1,max=1000"`
 		// 최대 로그 파일 백업 개수 (DEF:10, MIN:1, MAX:100)
-		MaxLogFileBackup int `yaml:"maxLogFileBackup"`
+		MaxLogFileBackup int `yaml:"maxLogFileBackup" toml:"maxLogFileBackup" json:"maxLogFileBackup" validate:"min=1,max=100"`
 		// 최대 백업 로그 파일 유지 기간(일) (DEF:90, MIN:1, MAX:365)
-		MaxLogFileAge int `yaml:"maxLogFileAge"`
+		MaxLogFileAge int `yaml:"maxLogFileAge" toml:"maxLogFileAge" json:"maxLogFileAge" validate:"min=1,max=365"`
 		// 백업 로그 파일 압축 여부 (DEF:true, ENABLE:true, DISABLE:false)
-		CompBakLogFile bool `yaml:"compressBackupLogFile"`
-	} `yaml:"log"`
+		CompBakLogFile bool `yaml:"compressBackupLogFile" toml:"compressBackupLogFile" json:"compressBackupLogFile"`
+		// 로그 파일 기록 레벨 (DEF:debug, debug/info/warn/error, 그 외 값은 info로 취급)
+		// 콘솔 출력 여부는 디버그 모드로 결정되며, DEBUG 로그는 디버그 모드에서만 기록됨
+		Level string `yaml:"level" toml:"level" json:"level"`
+		// 로그 파일 출력 형식 (DEF:console, console/json)
+		Format string `yaml:"format" toml:"format" json:"format" validate:"oneof=console json"`
+		// 로그 시간 출력 형식 (DEF:[2006-01-02 15:04:05], Go 시간 레이아웃, console 형식에만 적용)
+		// json 형식은 항상 ISO8601 형식으로 기록
+		TimeFormat string `yaml:"timeFormat" toml:"timeFormat" json:"timeFormat" validate:"required"`
+		// 로그 시간을 UTC로 기록할지 여부 (DEF:false, false면 로컬 시간)
+		UTC bool `yaml:"utc" toml:"utc" json:"utc"`
+		// 접근 로그를 구조화된 필드로 기록할지 여부 (DEF:false, false면 사람이 읽기 쉬운 한 줄 메시지)
+		StructuredAccessLog bool `yaml:"structuredAccessLog" toml:"structuredAccessLog" json:"structuredAccessLog"`
+		// 느린 요청 기준 시간 (밀리초 단위, DEF:1000, 0이면 비활성화)
+		// 처리 시간이 기준을 초과한 요청은 상태 코드와 관계없이 WARN 레벨로 기록
+		SlowRequestThresholdMs int `yaml:"slowRequestThresholdMs" toml:"slowRequestThresholdMs" json:"slowRequestThresholdMs" validate:"min=0,max=3600000"`
+		// 로그 샘플링: 1초 동안 같은 레벨, 같은 메시지의 로그 중 처음 기록할 개수 (DEF:0, 0이면 비활성화)
+		// 에러 폭주 시 디스크가 가득 차는 것을 막는 대신 일부 로그가 누락됨 (파일 로그에만 적용)
+		SampleInitial int `yaml:"sampleInitial" toml:"sampleInitial" json:"sampleInitial" validate:"min=0,max=100000"`
+		// 로그 샘플링: sampleInitial 개수 이후 N개마다 1개씩 기록 (DEF:0, 0이면 나머지 모두 버림)
+		SampleThereafter int `yaml:"sampleThereafter" toml:"sampleThereafter" json:"sampleThereafter" validate:"min=0,max=100000"`
+		// syslog 전송 여부 (DEF:false, 파일 로그와 함께 기록)
+		Syslog bool `yaml:"syslog" toml:"syslog" json:"syslog"`
+		// syslog 접속 네트워크 (DEF:"" 로컬 syslog 소켓, udp/tcp/unix/unixgram)
+		SyslogNetwork string `yaml:"syslogNetwork" toml:"syslogNetwork" json:"syslogNetwork" validate:"omitempty,oneof=udp tcp unix unixgram"`
+		// syslog 접속 주소 (ex: 127.0.0.1:514, syslogNetwork가 비어 있으면 무시)
+		SyslogAddress string `yaml:"syslogAddress" toml:"syslogAddress" json:"syslogAddress" validate:"required_with=SyslogNetwork"`
+	} `yaml:"log" toml:"log" json:"log"`
+
+	// 메트릭 설정
+	Metric struct {
+		// CPU 별 softirq 메트릭 수집 여부 (DEF:false)
+		// CPU 수 x softirq 타입 수 만큼 시계열이 생성되므로 필요한 경우에만 활성화
+		EnableSoftirqs bool `yaml:"enableSoftirqs" toml:"enableSoftirqs" json:"enableSoftirqs"`
+		// 스크래핑 시점에 리소스 사용률 계산 여부 (DEF:false)
+		// 백그라운드 수집 고루틴 없이 /metrics 요청 시마다 /proc를 직접 읽음.
+		// CPU/네트워크 사용률은 이전 스크래핑과의 간격을 기준으로 계산되므로
+		// 스크래핑 주기가 불규칙하면 사용률의 측정 구간도 불규칙해짐
+		CollectOnScrape bool `yaml:"collectOnScrape" toml:"collectOnScrape" json:"collectOnScrape"`
+		// 백그라운드 리소스 사용률 샘플링 주기 (DEF:5, MIN:1, MAX:3600, 단위:초)
+		// collectOnScrape가 활성화되어 있으면 사용하지 않음
+		SampleIntervalSec int `yaml:"sampleIntervalSec" toml:"sampleIntervalSec" json:"sampleIntervalSec" validate:"min=1,max=3600"`
+		// 메트릭명 접두사 (DEF:weblin_)
+		Namespace string `yaml:"namespace" toml:"namespace" json:"namespace"`
+		// 모든 메트릭에 적용되는 고정 라벨 (라벨명: 라벨값)
+		// hostname 라벨을 설정하지 않으면 호스트명으로 자동 설정
+		ConstLabels map[string]string `yaml:"constLabels" toml:"constLabels" json:"constLabels"`
+		// 메트릭 help 문자열 재정의 (네임스페이스를 제외한 메트릭명: help 문자열)
+		// 설정하지 않은 메트릭은 기본 help 문자열을 사용
+		HelpOverrides map[string]string `yaml:"helpOverrides" toml:"helpOverrides" json:"helpOverrides"`
+		// 리소스 사용률 최대값(*_peak) 추적 구간 (DEF:86400, MIN:60, MAX:2592000, 단위:초)
+		PeakWindowSec int `yaml:"peakWindowSec" toml:"peakWindowSec" json:"peakWindowSec" validate:"min=60,max=2592000"`
+		// 최대값 추적 방식 (DEF:true)
+		// true: 항상 최근 peakWindowSec 동안의 최대값, false: peakWindowSec 마다 최대값 초기화
+		PeakRolling bool `yaml:"peakRolling" toml:"peakRolling" json:"peakRolling"`
+		// 네트워크 메트릭 수집에서 제외할 인터페이스명 접두사 리스트 (DEF:없음)
+		// 설정하지 않으면 lo 인터페이스만 제외 (ex: [lo, docker0, veth, br-])
+		ExcludeInterfaces []string `yaml:"excludeInterfaces" toml:"excludeInterfaces" json:"excludeInterfaces"`
+		// 디스크 사용률을 측정할 경로 리스트 (DEF:[/])
+		// 경로 별로 mount 라벨을 붙여 메트릭을 생성하며, 비어 있으면 / 만 측정
+		DiskPaths []string `yaml:"diskPaths" toml:"diskPaths" json:"diskPaths" validate:"dive,required"`
+	} `yaml:"metric" toml:"metric" json:"metric"`
+
+	// 프로세스 설정
+	Process struct {
+		// Go 런타임 소프트 메모리 제한 (DEF:0 미사용, 단위:MB)
+		MemoryLimitMB int `yaml:"memoryLimitMB" toml:"memoryLimitMB" json:"memoryLimitMB" validate:"min=0"`
+		// cgroup 메모리 제한 대비 소프트 메모리 제한 비율 (DEF:0 미사용, MIN:1, MAX:100, 단위:%)
+		// memoryLimitMB가 설정되어 있으면 memoryLimitMB가 우선함
+		MemoryLimitCgroupPercent int `yaml:"memoryLimitCgroupPercent" toml:"memoryLimitCgroupPercent" json:"memoryLimitCgroupPercent" validate:"min=0,max=100"`
+		// 디버그 모드에서 힙 메모리 통계 로그 출력 주기 (DEF:0 미사용, MAX:3600, 단위:초)
+		MemReportIntervalSec int `yaml:"memReportIntervalSec" toml:"memReportIntervalSec" json:"memReportIntervalSec" validate:"min=0,max=3600"`
+		// 힙 메모리 통계 출력 전 GC 강제 실행 여부 (DEF:false)
+		MemReportForceGC bool `yaml:"memReportForceGC" toml:"memReportForceGC" json:"memReportForceGC"`
+		// ps/top에 표시되는 프로세스 타이틀 템플릿 (DEF:"" 미사용)
+		// {name}: 모듈명, {config}: 설정 파일명(확장자 제외), {port}: 리스닝 포트,
+		// {mode}: 동작 모드(normal/debug), {pid}: PID
+		Title string `yaml:"title" toml:"title" json:"title"`
+	} `yaml:"process" toml:"process" json:"process"`
+
+	// 시그널 처리 설정 (시그널명: handle|ignore|default)
+	// 설정하지 않은 시그널은 기본 동작을 따름
+	Signal map[string]string `yaml:"signal" toml:"signal" json:"signal"`
+
+	// 유효 범위를 벗어난 설정 값 처리 방식 (DEF:false)
+	// false: 잘못된 설정 값을 모두 나열한 에러 반환, true: 잘못된 설정 값을 기본값으로 대체
+	LenientValidation bool `yaml:"lenientValidation" toml:"lenientValidation" json:"lenientValidation"`
 }
 
+// 시그널 처리 동작
+const (
+	// 시그널 수신 시 weblin 종료 (SIGHUP은 로그 파일 재오픈 및 설정 재로드)
+	SignalHandle = "handle"
+	// 시그널 무시
+	SignalIgnore = "ignore"
+	// 시그널의 기본 동작(OS 기본 동작) 유지
+	SignalDefault = "default"
+)
+
 // TLSYaml TLS 설정 YAML 구조체
 type TLSYaml struct {
 	// TLS 사용 설정 (DEF:false)
-	Enabled bool `yaml:"enabled"`
+	Enabled bool `yaml:"enabled" toml:"enabled" json:"enabled"`
 	// TLS Certificate Path
-	TLSCertPath string `yaml:"tlsCertPath"`
+	TLSCertPath string `yaml:"tlsCertPath" toml:"tlsCertPath" json:"tlsCertPath"`
 	// TLS Private Key Path
-	TLSKeyPath string `yaml:"tlsKeyPath"`
+	TLSKeyPath string `yaml:"tlsKeyPath" toml:"tlsKeyPath" json:"tlsKeyPath"`
+	// 클라이언트 인증서 검증용 CA 인증서 경로 (설정 시 클라이언트 인증서 검증)
+	ClientCAPath string `yaml:"clientCAPath" toml:"clientCAPath" json:"clientCAPath"`
+	// 클라이언트 인증서 필수 여부 (DEF:false, false면 제출된 인증서만 검증)
+	RequireClientCert bool `yaml:"requireClientCert" toml:"requireClientCert" json:"requireClientCert"`
+}
+
+// CORSYaml CORS 설정 YAML 구조체
+type CORSYaml struct {
+	// 허용할 Origin 리스트 (DEF:[], 비어 있으면 CORS 비활성화, "*"는 모든 Origin 허용)
+	AllowOrigins []string `yaml:"allowOrigins" toml:"allowOrigins" json:"allowOrigins" validate:"dive,required"`
+	// 허용할 HTTP 메서드 리스트 (DEF:[], 비어 있으면 GET, HEAD, POST, PUT)
+	AllowMethods []string `yaml:"allowMethods" toml:"allowMethods" json:"allowMethods" validate:"dive,required"`
+	// 허용할 요청 헤더 리스트 (DEF:[], 비어 있으면 Authorization, Content-Type)
+	AllowHeaders []string `yaml:"allowHeaders" toml:"allowHeaders" json:"allowHeaders" validate:"dive,required"`
+	// 자격 증명(쿠키, Authorization 헤더) 포함 요청 허용 (DEF:false)
+	AllowCredentials bool `yaml:"allowCredentials" toml:"allowCredentials" json:"allowCredentials"`
+	// preflight 응답 캐시 시간 (초 단위, DEF:600)
+	MaxAgeSec int `yaml:"maxAgeSec" toml:"maxAgeSec" json:"maxAgeSec" validate:"min=0,max=86400"`
+}
+
+// BasicAuthYaml HTTP 기본 인증 설정 YAML 구조체
+type BasicAuthYaml struct {
+	// 인증 사용자명 (DEF:"", 비어 있으면 기본 인증 비활성화)
+	Username string `yaml:"username" toml:"username" json:"username"`
+	// bcrypt로 해시된 인증 비밀번호
+	PasswordHash string `yaml:"passwordHash" toml:"passwordHash" json:"passwordHash" validate:"required_with=Username"`
+	// 인증이 필요한 경로 리스트 (DEF:[], 비어 있으면 헬스 체크를 제외한 모든 경로)
+	Paths []string `yaml:"paths" toml:"paths" json:"paths" validate:"dive,required"`
 }
 
 // RunConfig 런타임 설정 정보 구조체
 type RunConfig struct {
 	DebugMode bool
 	Pid       int
+	// --config 플래그로 지정된 설정 파일 경로 (빈 문자열이면 ConfFilePath 사용)
+	ConfFilePath string
+}
+
+// ConfPath 사용할 설정 파일 경로 반환
+//
+// Returns:
+//   - string: --config 플래그로 지정된 경로, 지정되지 않았으면 ConfFilePath
+func (r *RunConfig) ConfPath() string {
+	if r.ConfFilePath != "" {
+		return r.ConfFilePath
+	}
+	return ConfFilePath
 }
 
 var RunConf RunConfig
 var Conf Config
 
+// 기본 설정 값 (lenientValidation 모드에서 잘못된 설정 값 대체 시 사용)
+var defaultConf Config
+
+var (
+	loadTimeMu sync.RWMutex
+	// 현재 적용된 설정 파일의 로드 시간
+	loadTime time.Time
+	// 현재 적용된 설정 파일 로드 시 기본값으로 대체된 설정 값 경고
+	loadWarnings []string
+)
+
 // 패키지 임포트 시 초기화
 func init() {
 	Conf.Server.Port = 8443
+	Conf.Server.ReadTimeoutSec = 10
+	Conf.Server.WriteTimeoutSec = 10
+	Conf.Server.ShutdownTimeoutSec = 5
 	Conf.API.MetricURI = "/metrics"
 	Conf.API.HealthURI = "/health"
+	Conf.API.ReadyURI = "/ready"
 	Conf.API.SysStatURI = "/sys/stats"
+	Conf.API.SysStatResetURI = "/sys/stats/reset"
+	Conf.API.WSStatsURI = "/ws/stats"
+	Conf.API.WSMaxClients = 16
+	Conf.API.WSSendBuffer = 4
+	Conf.API.WSSlowClientPolicy = "drop"
+	Conf.API.CORS.MaxAgeSec = 600
+	Conf.API.RateLimitBurst = 20
+	Conf.API.GzipMinSize = 1024
 	Conf.Log.MaxLogFileSize = 100
 	Conf.Log.MaxLogFileBackup = 10
 	Conf.Log.MaxLogFileAge = 90
 	Conf.Log.CompBakLogFile = true
+	Conf.Log.Level = "debug"
+	Conf.Log.Format = "console"
+	Conf.Log.TimeFormat = "[2006-01-02 15:04:05]"
+	Conf.Log.SlowRequestThresholdMs = 1000
+	Conf.Metric.Namespace = "weblin_"
+	Conf.Metric.SampleIntervalSec = 5
+	Conf.Metric.PeakWindowSec = 86400
+	Conf.Metric.PeakRolling = true
+	Conf.Metric.DiskPaths = []string{"/"}
+
+	defaultConf = Conf
+}
+
+// DefaultConfig 기본 설정 값으로 초기화된 설정 정보 구조체 반환
+//
+// 설정 재로드 시 현재 설정과 분리된 구조체에 설정 파일을 로드하기 위해 사용
+//
+// Returns:
+//   - Config: 기본 설정 값
+func DefaultConfig() Config {
+	c := defaultConf
+	c.Metric.DiskPaths = slices.Clone(defaultConf.Metric.DiskPaths)
+	return c
 }
 
 // LoadConfig 설정 파일 로드
 //
 // Parameters:
-//   - filePath: 설정 파일 경로
+//   - filePath: 설정 파일 경로 (빈 문자열이면 ConfFilePath 사용)
 //
 // Returns:
 //   - error: 성공(nil), 실패(error)
 func (c *Config) LoadConfig(filePath string) error {
-	// YAML 설정 파일 열기
-	file, err := os.Open(ConfFilePath)
+	if filePath == "" {
+		filePath = ConfFilePath
+	}
+
+	// 설정 파일 열기
+	file, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to open file: %v", err)
 	}
 	defer file.Close()
 
-	// YAML 디코더 생성
-	decoder := yaml.NewDecoder(file)
-
-	// YAML 파싱 및 디코딩
-	err = decoder.Decode(&Conf)
+	// 확장자에 맞는 형식으로 파싱 및 디코딩
+	err = c.decode(file, filePath)
 	if err != nil {
 		return fmt.Errorf("failed to parse config: %v", err)
 	}
 
-	// 설정 값 유효성 검사
-	if c.Server.Port < 1 || c.Server.Port > 65535 {
-		c.Server.Port = 8443
-	}
-	if c.Log.MaxLogFileSize < 1 || c.Log.MaxLogFileSize > 1000 {
-		c.Log.MaxLogFileSize = 100
-	}
-	if c.Log.MaxLogFileBackup < 1 || c.Log.MaxLogFileBackup > 100 {
-		c.Log.MaxLogFileBackup = 10
+	return c.finishLoad()
+}
+
+// LoadDefaults 설정 파일 없이 기본 설정 값으로 로드
+//
+// 설정 파일을 로드한 경우와 같이 환경 변수 재정의 및 유효성 검사를 적용
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (c *Config) LoadDefaults() error {
+	return c.finishLoad()
+}
+
+// finishLoad 환경 변수로 설정 값을 재정의하고 유효성 검사 후 로드 시간 기록
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (c *Config) finishLoad() error {
+	// 환경 변수로 설정 값 재정의 (설정 파일보다 우선)
+	err := c.applyEnvOverrides()
+	if err != nil {
+		return err
 	}
-	if c.Log.MaxLogFileAge < 1 || c.Log.MaxLogFileAge > 365 {
-		c.Log.MaxLogFileAge = 90
+
+	// 설정 값 유효성 검사
+	warnings, err := c.validate()
+	if err != nil {
+		return err
 	}
 
+	// 설정 로드 시간 및 경고 기록
+	loadTimeMu.Lock()
+	loadTime = time.Now()
+	loadWarnings = warnings
+	loadTimeMu.Unlock()
+
 	return nil
 }
+
+// decode 설정 파일 확장자에 맞는 디코더로 설정 정보 디코딩
+//
+// .toml은 TOML, .json은 JSON, 그 외(.yaml, .yml 포함)는 YAML로 디코딩
+//
+// Parameters:
+//   - r: 설정 파일 reader
+//   - filePath: 설정 파일 경로 (형식 판별용)
+//
+// Returns:
+//   - error: 정상 종료(nil), 비정상 종료(error)
+func (c *Config) decode(r io.Reader, filePath string) error {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".toml":
+		return toml.NewDecoder(r).Decode(c)
+	case ".json":
+		return json.NewDecoder(r).Decode(c)
+	default:
+		return yaml.NewDecoder(r).Decode(c)
+	}
+}
+
+// LoadWarnings 마지막 설정 로드 시 기본값으로 대체된 설정 값 경고 리스트 반환
+//
+// 설정 로드 시점에는 로거가 초기화되지 않았을 수 있으므로 호출자가 로거 초기화 후 기록
+//
+// Returns:
+//   - []string: 경고 메시지 리스트 (ex: "server.port: must be <= 65535 (got 84430), reset to default (8443)")
+func LoadWarnings() []string {
+	loadTimeMu.RLock()
+	defer loadTimeMu.RUnlock()
+
+	return slices.Clone(loadWarnings)
+}
+
+// LoadTime 현재 적용된 설정 파일의 로드 시간 반환
+//
+// Returns:
+//   - time.Time: 설정 로드 시간 (로드된 적 없으면 zero value)
+func LoadTime() time.Time {
+	loadTimeMu.RLock()
+	defer loadTimeMu.RUnlock()
+
+	return loadTime
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLoadDefaults 설정 파일 없이 로드해도 환경 변수 재정의와 유효성 검사가 적용되는지 확인
+func TestLoadDefaults(t *testing.T) {
+	t.Setenv("WEBLIN_SERVER_PORT", "9443")
+
+	c := DefaultConfig()
+	if err := c.LoadDefaults(); err != nil {
+		t.Fatalf("LoadDefaults failed: %v", err)
+	}
+	if c.Server.Port != 9443 {
+		t.Fatalf("server.port = %d, want 9443", c.Server.Port)
+	}
+	if c.API.HealthURI != "/health" {
+		t.Fatalf("api.healthURI = %q, want default %q", c.API.HealthURI, "/health")
+	}
+	if LoadTime().IsZero() {
+		t.Fatal("load time not recorded")
+	}
+
+	t.Setenv("WEBLIN_SERVER_PORT", "70000")
+	c = DefaultConfig()
+	if err := c.LoadDefaults(); err == nil {
+		t.Fatal("LoadDefaults succeeded with an out of range port")
+	}
+}
+
+// TestDefaultConfigFile 확장자별로 생성한 기본 설정 파일을 LoadConfig로 다시 로드할 수 있는지 확인
+func TestDefaultConfigFile(t *testing.T) {
+	for _, name := range []string{"weblin.yaml", "weblin.yml", "weblin.toml", "weblin.json"} {
+		t.Run(name, func(t *testing.T) {
+			data, err := DefaultConfigFile(name)
+			if err != nil {
+				t.Fatalf("DefaultConfigFile failed: %v", err)
+			}
+			path := filepath.Join(t.TempDir(), name)
+			if err := os.WriteFile(path, data, 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+
+			// 기본값과 다른 값에서 시작하여 파일 값이 실제로 적용되는지 확인
+			c := DefaultConfig()
+			c.Server.Port = 1
+			if err := c.LoadConfig(path); err != nil {
+				t.Fatalf("LoadConfig failed: %v", err)
+			}
+			if c.Server.Port != 8443 {
+				t.Fatalf("server.port = %d, want 8443", c.Server.Port)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Diff 두 설정 간 값이 다른 설정 항목 경로 리스트 반환
+//
+// Parameters:
+//   - old: 이전 설정
+//   - new: 새 설정
+//
+// Returns:
+//   - []string: 값이 다른 설정 항목의 YAML 경로 리스트 (ex: server.port, log.level)
+func Diff(old, new Config) []string {
+	return diffStruct(reflect.ValueOf(old), reflect.ValueOf(new), "")
+}
+
+// diffStruct 구조체의 각 필드 값을 비교하여 값이 다른 필드 경로 수집
+//
+// Parameters:
+//   - a: 비교할 구조체 값
+//   - b: 비교할 구조체 값
+//   - prefix: YAML 경로 접두사
+//
+// Returns:
+//   - []string: 값이 다른 필드의 YAML 경로 리스트
+func diffStruct(a, b reflect.Value, prefix string) []string {
+	var changed []string
+
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if key == "" || key == "-" || !field.IsExported() {
+			continue
+		}
+		path := prefix + key
+
+		if field.Type.Kind() == reflect.Struct {
+			changed = append(changed, diffStruct(a.Field(i), b.Field(i), path+".")...)
+			continue
+		}
+
+		// nil과 빈 리스트/맵은 같은 값으로 취급
+		fa, fb := a.Field(i), b.Field(i)
+		if (fa.Kind() == reflect.Slice || fa.Kind() == reflect.Map) && fa.Len() == 0 && fb.Len() == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(fa.Interface(), fb.Interface()) {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package config
+
+import (
+	_ "embed"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// DefaultYAML 기본 설정 값과 설명 주석을 포함한 설정 파일 내용 (weblin.yaml)
+//
+//go:embed weblin.yaml
+var DefaultYAML []byte
+
+// DefaultConfigFile 설정 파일 확장자에 맞는 형식의 기본 설정 파일 내용 반환
+//
+// LoadConfig와 같이 .toml은 TOML, .json은 JSON, 그 외(.yaml, .yml 포함)는 YAML로 작성.
+// 설명 주석은 YAML 형식에만 포함됨.
+//
+// Parameters:
+//   - filePath: 설정 파일 경로 (형식 판별용)
+//
+// Returns:
+//   - []byte: 기본 설정 파일 내용
+//   - error: 성공(nil), 실패(error)
+func DefaultConfigFile(filePath string) ([]byte, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".toml":
+		return toml.Marshal(DefaultConfig())
+	case ".json":
+		data, err := json.MarshalIndent(DefaultConfig(), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		return DefaultYAML, nil
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 환경 변수 재정의 접두사
+const envPrefix = "WEBLIN_"
+
+// applyEnvOverrides 환경 변수로 설정 값 재정의
+//
+// 환경 변수명은 WEBLIN_<SECTION>_<FIELD> 형식이며, YAML 키를 대문자 스네이크 케이스로 변환하여 사용.
+// 중첩된 섹션은 경로 순서대로 연결함.
+//   - server.port -> WEBLIN_SERVER_PORT
+//   - server.tls.enabled -> WEBLIN_SERVER_TLS_ENABLED
+//   - log.maxLogFileSize -> WEBLIN_LOG_MAX_LOG_FILE_SIZE
+//   - lenientValidation -> WEBLIN_LENIENT_VALIDATION
+//
+// 리스트는 쉼표로 구분하고(ex: /,/var), 맵은 key=value를 쉼표로 구분함(ex: env=prod,region=kr).
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (c *Config) applyEnvOverrides() error {
+	return applyEnvToStruct(reflect.ValueOf(c).Elem(), envPrefix)
+}
+
+// applyEnvToStruct 구조체의 각 필드에 대응하는 환경 변수 값 적용
+//
+// Parameters:
+//   - v: 구조체 값
+//   - prefix: 환경 변수명 접두사
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func applyEnvToStruct(v reflect.Value, prefix string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if key == "" || key == "-" || !field.IsExported() {
+			continue
+		}
+		name := prefix + toEnvName(key)
+
+		// 하위 섹션은 재귀적으로 처리
+		if field.Type.Kind() == reflect.Struct {
+			if err := applyEnvToStruct(v.Field(i), name+"_"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setFieldFromString(v.Field(i), value); err != nil {
+			return fmt.Errorf("invalid environment variable %s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// setFieldFromString 문자열 값을 필드 타입에 맞게 변환하여 설정
+//
+// Parameters:
+//   - field: 설정할 필드
+//   - value: 환경 변수 값
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func setFieldFromString(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", field.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	case reflect.Map:
+		if field.Type().Key().Kind() != reflect.String || field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", field.Type())
+		}
+		m := make(map[string]string)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			k, val, found := strings.Cut(item, "=")
+			if !found {
+				return fmt.Errorf("expected key=value (got %s)", item)
+			}
+			m[strings.TrimSpace(k)] = strings.TrimSpace(val)
+		}
+		field.Set(reflect.ValueOf(m))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
+
+// toEnvName YAML 키(카멜 케이스)를 환경 변수명(대문자 스네이크 케이스)으로 변환
+//
+// Parameters:
+//   - key: YAML 키 (ex: maxLogFileSize, metricURI, memoryLimitMB)
+//
+// Returns:
+//   - string: 환경 변수명 (ex: MAX_LOG_FILE_SIZE, METRIC_URI, MEMORY_LIMIT_MB)
+func toEnvName(key string) string {
+	runes := []rune(key)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// 소문자/숫자 다음의 대문자, 또는 약어(URI 등) 뒤에서 새 단어가 시작되는 위치
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
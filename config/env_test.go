@@ -0,0 +1,93 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestConfig 임시 디렉터리에 YAML 설정 파일 생성
+//
+// Parameters:
+//   - t: 테스트 상태
+//   - content: 설정 파일 내용
+//
+// Returns:
+//   - string: 설정 파일 경로
+func writeTestConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "weblin.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return path
+}
+
+// TestEnvOverridesFile 환경 변수 값이 설정 파일 값보다 우선 적용되는지 확인
+func TestEnvOverridesFile(t *testing.T) {
+	path := writeTestConfig(t, "server:\n  port: 8443\nlog:\n  level: info\n")
+
+	t.Setenv("WEBLIN_SERVER_PORT", "9443")
+	t.Setenv("WEBLIN_LOG_LEVEL", "warn")
+	t.Setenv("WEBLIN_METRIC_DISK_PATHS", "/, /var")
+
+	c := DefaultConfig()
+	if err := c.LoadConfig(path); err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	if c.Server.Port != 9443 {
+		t.Fatalf("server.port = %d, want 9443", c.Server.Port)
+	}
+	if c.Log.Level != "warn" {
+		t.Fatalf("log.level = %q, want %q", c.Log.Level, "warn")
+	}
+	if got := strings.Join(c.Metric.DiskPaths, ","); got != "/,/var" {
+		t.Fatalf("metric.diskPaths = %q, want %q", got, "/,/var")
+	}
+}
+
+// TestEnvOverridesValidated 환경 변수로 재정의된 값도 유효성 검사를 거치는지 확인
+func TestEnvOverridesValidated(t *testing.T) {
+	path := writeTestConfig(t, "server:\n  port: 8443\n")
+
+	tests := []struct {
+		name, key, value string
+		// 에러 메시지에 포함되어야 하는 문자열
+		wantErr string
+	}{
+		{"out of range port", "WEBLIN_SERVER_PORT", "70000", "server.port: must be <= 65535"},
+		{"non-numeric port", "WEBLIN_SERVER_PORT", "https", "invalid environment variable WEBLIN_SERVER_PORT"},
+		{"invalid bool", "WEBLIN_SERVER_TLS_ENABLED", "maybe", "invalid environment variable WEBLIN_SERVER_TLS_ENABLED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.key, tt.value)
+
+			c := DefaultConfig()
+			err := c.LoadConfig(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("LoadConfig error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
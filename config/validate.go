@@ -0,0 +1,236 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/meloncoffee/weblin/pkg/utils/process"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// Prometheus 메트릭명 접두사 형식
+	metricNamespaceRe = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	// Prometheus 라벨명 형식
+	metricLabelNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// validate 설정 값 유효성 검사
+//
+// 구조체 필드의 `validate` 태그를 기준으로 검사하며, 잘못된 설정 값을 모두 모아서 하나의 에러로 반환.
+// lenientValidation 모드에서는 범위를 벗어난 값을 기본값으로 대체하고 에러 대신 경고로 반환.
+//
+// Returns:
+//   - []string: 기본값으로 대체된 설정 값 경고 리스트 (lenientValidation 모드)
+//   - error: 성공(nil), 실패(error)
+func (c *Config) validate() ([]string, error) {
+	var problems, warnings []string
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	// 에러 메시지에 YAML 키 이름을 사용
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+
+	err := validate.Struct(c)
+	var fieldErrs validator.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		for _, fe := range fieldErrs {
+			if c.LenientValidation {
+				if value, ok := c.resetToDefault(fe.StructNamespace()); ok {
+					warnings = append(warnings, fmt.Sprintf("%s, reset to default (%v)",
+						describeFieldError(fe), value))
+					continue
+				}
+			}
+			problems = append(problems, describeFieldError(fe))
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to validate config: %v", err)
+	}
+
+	// 시그널 처리 설정 검사 (에러 메시지 순서를 고정하기 위해 정렬)
+	signalNames := make([]string, 0, len(c.Signal))
+	for name := range c.Signal {
+		signalNames = append(signalNames, name)
+	}
+	sort.Strings(signalNames)
+	for _, name := range signalNames {
+		action := c.Signal[name]
+		sig, err := process.ParseSignal(name)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("signal.%s: %v", name, err))
+			continue
+		}
+		// SIGUSR1은 내부 에러 발생 시 종료 신호로 사용하므로 변경 불가
+		if sig == syscall.SIGUSR1 {
+			problems = append(problems, fmt.Sprintf("signal.%s: reserved signal", name))
+			continue
+		}
+		if action != SignalHandle && action != SignalIgnore && action != SignalDefault {
+			problems = append(problems, fmt.Sprintf("signal.%s: unknown action (%s)", name, action))
+		}
+	}
+
+	// TLS 인증서 및 키 파일 검사 (상대 경로는 실행 파일 경로 기준)
+	if c.Server.TLS.Enabled {
+		tlsFiles := []struct {
+			key, path string
+			required  bool
+		}{
+			{"server.tls.tlsCertPath", c.Server.TLS.TLSCertPath, true},
+			{"server.tls.tlsKeyPath", c.Server.TLS.TLSKeyPath, true},
+			{"server.tls.clientCAPath", c.Server.TLS.ClientCAPath, c.Server.TLS.RequireClientCert},
+		}
+		for _, f := range tlsFiles {
+			if f.path == "" {
+				if !f.required {
+					continue
+				}
+				if f.key == "server.tls.clientCAPath" {
+					problems = append(problems, fmt.Sprintf("%s: is required when requireClientCert is set", f.key))
+					continue
+				}
+				problems = append(problems, fmt.Sprintf("%s: is required when tls is enabled", f.key))
+				continue
+			}
+			if fp, err := os.Open(f.path); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", f.key, err))
+			} else {
+				fp.Close()
+			}
+		}
+	}
+
+	// 자격 증명 허용 시 모든 Origin 허용 금지 (브라우저가 거부함)
+	if c.API.CORS.AllowCredentials && slices.Contains(c.API.CORS.AllowOrigins, "*") {
+		problems = append(problems, `api.cors.allowOrigins: "*" is not allowed when allowCredentials is set`)
+	}
+
+	// 기본 인증 비밀번호 해시 형식 검사
+	if hash := c.API.BasicAuth.PasswordHash; hash != "" {
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			problems = append(problems, fmt.Sprintf("api.basicAuth.passwordHash: invalid bcrypt hash (%v)", err))
+		}
+	}
+
+	// 메트릭 네임스페이스 및 고정 라벨명 검사
+	if ns := c.Metric.Namespace; ns != "" && !metricNamespaceRe.MatchString(ns) {
+		problems = append(problems, fmt.Sprintf("metric.namespace: invalid metric name prefix (%s)", ns))
+	}
+	labelNames := make([]string, 0, len(c.Metric.ConstLabels))
+	for name := range c.Metric.ConstLabels {
+		labelNames = append(labelNames, name)
+	}
+	sort.Strings(labelNames)
+	for _, name := range labelNames {
+		// __ 접두사는 Prometheus 내부 라벨용으로 예약됨
+		if !metricLabelNameRe.MatchString(name) || strings.HasPrefix(name, "__") {
+			problems = append(problems, fmt.Sprintf("metric.constLabels.%s: invalid label name", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid config: %s", strings.Join(problems, "; "))
+	}
+
+	return warnings, nil
+}
+
+// resetToDefault 지정된 설정 필드를 기본값으로 대체
+//
+// Parameters:
+//   - namespace: 구조체 필드 경로 (ex: Config.Server.Port)
+//
+// Returns:
+//   - interface{}: 대체된 기본값
+//   - bool: 대체 성공(true), 대체할 수 없는 필드(false)
+func (c *Config) resetToDefault(namespace string) (interface{}, bool) {
+	names := strings.Split(namespace, ".")
+	if len(names) < 2 {
+		return nil, false
+	}
+
+	dst := reflect.ValueOf(c).Elem()
+	src := reflect.ValueOf(&defaultConf).Elem()
+	// 첫 번째 요소는 최상위 구조체명(Config)이므로 제외
+	for _, name := range names[1:] {
+		dst = dst.FieldByName(name)
+		src = src.FieldByName(name)
+		if !dst.IsValid() || !src.IsValid() {
+			return nil, false
+		}
+	}
+
+	if !dst.CanSet() {
+		return nil, false
+	}
+	dst.Set(src)
+
+	return src.Interface(), true
+}
+
+// describeFieldError 유효성 검사 에러를 사람이 읽을 수 있는 메시지로 변환
+//
+// Parameters:
+//   - fe: 필드 유효성 검사 에러
+//
+// Returns:
+//   - string: 에러 메시지 (ex: "server.port: must be <= 65535 (got 84430)")
+func describeFieldError(fe validator.FieldError) string {
+	// 최상위 구조체명(Config) 제외
+	_, field, _ := strings.Cut(fe.Namespace(), ".")
+
+	var reason string
+	switch fe.Tag() {
+	case "min":
+		reason = "must be >= " + fe.Param()
+	case "max":
+		reason = "must be <= " + fe.Param()
+	case "oneof":
+		reason = "must be one of [" + fe.Param() + "]"
+	case "required":
+		reason = "is required"
+	case "required_with":
+		// 파라미터는 구조체 필드명이므로 YAML 키 형식(첫 글자 소문자)으로 변환
+		param := fe.Param()
+		if param != "" {
+			param = strings.ToLower(param[:1]) + param[1:]
+		}
+		reason = "is required when " + param + " is set"
+	case "cidr|ip":
+		reason = "must be an IP address or CIDR"
+	default:
+		reason = "failed '" + fe.Tag() + "' validation"
+	}
+
+	return fmt.Sprintf("%s: %s (got %v)", field, reason, fe.Value())
+}
@@ -22,9 +22,14 @@ package logger
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/meloncoffee/weblin/config"
+	"github.com/meloncoffee/weblin/pkg/utils/file"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -32,30 +37,84 @@ import (
 
 // Logger 인터페이스
 type Logger interface {
-	InitializeLogger()
+	InitializeLogger() error
+	InitializeLoggerWithWriter(ws zapcore.WriteSyncer)
 	FinalizeLogger()
+	Reopen() error
+	Reconfigure(conf *config.Config)
+	Initialized() bool
+	Level() string
+	SetLevel(level string) error
 	LogInfo(format string, args ...interface{})
 	LogWarn(format string, args ...interface{})
 	LogError(format string, args ...interface{})
 	LogDebug(format string, args ...interface{})
 	LogPanic(format string, args ...interface{})
 	LogFatal(format string, args ...interface{})
+	LogInfow(msg string, keysAndValues ...interface{})
+	LogWarnw(msg string, keysAndValues ...interface{})
+	LogErrorw(msg string, keysAndValues ...interface{})
+	LogDebugw(msg string, keysAndValues ...interface{})
 }
 
+// 로그 샘플링 구간
+const logSampleTick = time.Second
+
 // SyncLogger 로그 관리 정보 구조체
 type SyncLogger struct {
-	fileLogger *lumberjack.Logger
+	// 로그 파일 Writer (외부 Writer로 초기화한 경우 nil)
+	fileLogger *fileWriter
 	zapLogger  *zap.Logger
+	sugar      *zap.SugaredLogger
+	// 파일(syslog 포함) 로그 기록 레벨 (런타임 중 변경 가능)
+	level zap.AtomicLevel
+	// 런타임 중 로그 레벨이 변경되었는지 여부 (디버그 모드가 아니어도 DEBUG 로그 기록)
+	levelChanged atomic.Bool
+	// 마지막으로 적용한 설정 파일의 로그 레벨 (설정 재로드 시 변경 여부 확인용)
+	confLevel string
+	// 로거 초기화 완료 여부
+	initialized atomic.Bool
 }
 
 var Log Logger = &SyncLogger{}
 
 // InitializeLogger 로거 초기화
-func (s *SyncLogger) InitializeLogger() {
-	var cores []zapcore.Core
+//
+// Returns:
+//   - error: 성공(nil), 로그 디렉터리 생성 실패(error)
+func (s *SyncLogger) InitializeLogger() error {
+	// 로그 디렉터리 생성 (rwxr-xr-x)
+	err := file.EnsureDir(filepath.Dir(config.LogFilePath), file.DefaultDirPerm)
+	if err != nil {
+		return fmt.Errorf("failed to create log directory: %v", err)
+	}
 
 	// Lumberjack 생성 (자동으로 로그 파일 관리)
-	s.fileLogger = s.newLumberJackLogger(config.LogFilePath)
+	s.fileLogger = &fileWriter{logger: s.newLumberJackLogger(config.LogFilePath, &config.Conf)}
+
+	s.initialize(zapcore.AddSync(s.fileLogger))
+
+	return nil
+}
+
+// InitializeLoggerWithWriter 로그 파일 대신 지정한 Writer로 기록하는 로거 초기화
+//
+// 로그 출력을 버퍼로 받아 확인하거나 다른 대상으로 전달할 때 사용.
+// 로그 파일 관리(lumberjack) 설정은 적용되지 않으며 Reopen()은 아무 동작도 하지 않음.
+//
+// Parameters:
+//   - ws: 로그를 기록할 Writer (ex: zapcore.AddSync(&bytes.Buffer{}))
+func (s *SyncLogger) InitializeLoggerWithWriter(ws zapcore.WriteSyncer) {
+	s.fileLogger = nil
+	s.initialize(ws)
+}
+
+// initialize 로그 출력 코어 구성 및 zap 로거 생성
+//
+// Parameters:
+//   - ws: 파일 로그 코어가 기록할 Writer
+func (s *SyncLogger) initialize(ws zapcore.WriteSyncer) {
+	var cores []zapcore.Core
 
 	// 인코더 설정
 	encoderConfig := zapcore.EncoderConfig{
@@ -67,7 +126,7 @@ func (s *SyncLogger) InitializeLogger() {
 		StacktraceKey:    "stacktrace",
 		LineEnding:       zapcore.DefaultLineEnding,
 		EncodeLevel:      s.capitalLevelEncoder,
-		EncodeTime:       zapcore.TimeEncoderOfLayout("[2006-01-02 15:04:05]"),
+		EncodeTime:       timeEncoder(zapcore.TimeEncoderOfLayout(config.Conf.Log.TimeFormat)),
 		EncodeDuration:   zapcore.SecondsDurationEncoder,
 		EncodeCaller:     s.wrapShortCallerEncoder,
 		ConsoleSeparator: " ",
@@ -76,10 +135,29 @@ func (s *SyncLogger) InitializeLogger() {
 	// 콘솔 인코더 생성
 	consoleEncoder := zapcore.NewConsoleEncoder(encoderConfig)
 
+	// 파일 로그 인코더 생성
+	fileEncoder := consoleEncoder
+	if config.Conf.Log.Format == "json" {
+		// JSON 형식에서는 값에 대괄호를 붙이지 않고 파싱하기 쉬운 형식 사용
+		jsonConfig := encoderConfig
+		jsonConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+		jsonConfig.EncodeTime = timeEncoder(zapcore.ISO8601TimeEncoder)
+		jsonConfig.EncodeCaller = zapcore.ShortCallerEncoder
+		fileEncoder = zapcore.NewJSONEncoder(jsonConfig)
+	}
+
 	// 파일 로그 출력을 위한 코어 설정
-	fileWriter := zapcore.AddSync(s.fileLogger)
+	fileLevel, levelKnown := parseLevel(config.Conf.Log.Level)
+	s.level = zap.NewAtomicLevelAt(fileLevel)
+	s.confLevel = config.Conf.Log.Level
+	fileCore := zapcore.NewCore(fileEncoder, ws, s.level)
+	// 로그 샘플링이 설정되어 있으면 1초 동안 같은 메시지가 반복될 때 일부만 기록 (디스크 보호)
+	if config.Conf.Log.SampleInitial > 0 {
+		fileCore = zapcore.NewSamplerWithOptions(fileCore, logSampleTick,
+			config.Conf.Log.SampleInitial, config.Conf.Log.SampleThereafter)
+	}
 	// 파일 로그 코어 추가
-	cores = append(cores, zapcore.NewCore(consoleEncoder, fileWriter, zapcore.DebugLevel))
+	cores = append(cores, fileCore)
 
 	// 디버그 모드일 경우 로그를 콘솔로도 출력
 	if config.RunConf.DebugMode {
@@ -95,36 +173,197 @@ func (s *SyncLogger) InitializeLogger() {
 		cores = append(cores, zapcore.NewCore(consoleEncoder, consoleErr, stderrLevel))
 	}
 
+	// syslog 전송이 활성화되어 있으면 syslog 코어 추가
+	// syslog가 시간과 호스트명을 기록하므로 시간 필드는 제외
+	var syslogErr error
+	if config.Conf.Log.Syslog {
+		syslogConfig := encoderConfig
+		syslogConfig.TimeKey = zapcore.OmitKey
+		syslogCore, err := newSyslogCore(config.Conf.Log.SyslogNetwork, config.Conf.Log.SyslogAddress,
+			config.ModuleName, zapcore.NewConsoleEncoder(syslogConfig), s.level)
+		if err != nil {
+			syslogErr = err
+		} else {
+			cores = append(cores, syslogCore)
+		}
+	}
+
 	// 코어 생성
 	core := zapcore.NewTee(cores...)
 
 	// 코어로 부터 로거 생성
 	s.zapLogger = zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1),
 		zap.AddStacktrace(zapcore.PanicLevel))
+	// 키-값 필드 로깅용 로거 생성
+	s.sugar = s.zapLogger.Sugar()
+
+	s.initialized.Store(true)
+
+	if syslogErr != nil {
+		s.LogWarn("Failed to connect to syslog, logging to file only: %v", syslogErr)
+	}
+	if !levelKnown {
+		s.LogWarn("Unknown log level (%s), using info", config.Conf.Log.Level)
+	}
+}
+
+// parseLevel 설정 파일의 로그 레벨 문자열을 zapcore 로그 레벨로 변환
+//
+// Parameters:
+//   - level: 로그 레벨 문자열 (debug, info, warn, error)
+//
+// Returns:
+//   - zapcore.Level: zapcore 로그 레벨 (알 수 없는 값이면 INFO)
+//   - bool: 알려진 로그 레벨 여부
+func parseLevel(level string) (zapcore.Level, bool) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return zapcore.DebugLevel, true
+	case "info":
+		return zapcore.InfoLevel, true
+	case "warn":
+		return zapcore.WarnLevel, true
+	case "error":
+		return zapcore.ErrorLevel, true
+	default:
+		return zapcore.InfoLevel, false
+	}
+}
+
+// timeEncoder 설정에 따라 UTC 시간으로 변환하는 시간 인코더 생성
+//
+// Parameters:
+//   - enc: 시간 인코더
+//
+// Returns:
+//   - zapcore.TimeEncoder: utc 설정이 켜져 있으면 UTC로 변환 후 enc를 호출하는 인코더, 꺼져 있으면 enc
+func timeEncoder(enc zapcore.TimeEncoder) zapcore.TimeEncoder {
+	if !config.Conf.Log.UTC {
+		return enc
+	}
+	return func(t time.Time, pae zapcore.PrimitiveArrayEncoder) {
+		enc(t.UTC(), pae)
+	}
 }
 
 // FinalizeLogger 프로그램 종료 시 로그 자원 정리
 func (s *SyncLogger) FinalizeLogger() {
+	s.initialized.Store(false)
 	// 버퍼에 남아있는 로그를 전부 파일에 기록
 	s.zapLogger.Sync()
 	// 열려 있는 로그 파일을 닫아줌
-	s.fileLogger.Close()
+	if s.fileLogger != nil {
+		s.fileLogger.Close()
+	}
+}
+
+// Initialized 로거 초기화 완료 여부 확인
+//
+// Returns:
+//   - bool: 초기화 완료(true), 초기화 전 또는 종료됨(false)
+func (s *SyncLogger) Initialized() bool {
+	return s.initialized.Load()
+}
+
+// Reopen 로그 파일을 닫고 다시 열기
+//
+// 외부 logrotate로 로그 파일이 이동된 경우 기존 inode에 계속 기록되는 것을 방지.
+// lumberjack의 Rotate()는 현재 파일을 백업 파일로 한 번 더 이동시키므로 Close()를 사용하며,
+// 닫힌 파일은 다음 로그 기록 시 설정된 경로로 다시 열림.
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (s *SyncLogger) Reopen() error {
+	// 버퍼에 남아있는 로그를 기존 파일에 기록
+	s.zapLogger.Sync()
+	if s.fileLogger == nil {
+		return nil
+	}
+	return s.fileLogger.Close()
+}
+
+// Reconfigure 설정 재로드 후 변경된 로그 설정 적용
+//
+// 로그 파일 관리 설정(크기, 백업 개수, 보관 기간, 압축 여부)과 로그 레벨을 적용.
+// 로그 레벨이 변경된 경우 SetLevel과 같이 적용되어 디버그 모드가 아니어도 DEBUG 로그가 기록됨.
+// 출력 형식, syslog 설정은 로거 재생성이 필요하므로 적용하지 않음.
+//
+// Parameters:
+//   - conf: 재로드한 설정
+func (s *SyncLogger) Reconfigure(conf *config.Config) {
+	s.zapLogger.Sync()
+	if s.fileLogger != nil {
+		s.fileLogger.swap(s.newLumberJackLogger(config.LogFilePath, conf))
+	}
+
+	if conf.Log.Level != s.confLevel {
+		s.confLevel = conf.Log.Level
+		level, ok := parseLevel(conf.Log.Level)
+		if !ok {
+			s.LogWarn("Unknown log level (%s), using info", conf.Log.Level)
+		}
+		s.setLevel(level)
+	}
+}
+
+// Level 현재 파일 로그 기록 레벨 반환
+//
+// Returns:
+//   - string: 로그 레벨 (debug, info, warn, error)
+func (s *SyncLogger) Level() string {
+	return s.level.Level().String()
+}
+
+// SetLevel 파일 로그 기록 레벨 변경 (재시작 없이 적용)
+//
+// 변경 이후에는 디버그 모드가 아니어도 설정된 레벨에 따라 DEBUG 로그가 기록됨
+//
+// Parameters:
+//   - level: 로그 레벨 (debug, info, warn, error)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (s *SyncLogger) SetLevel(level string) error {
+	l, ok := parseLevel(level)
+	if !ok {
+		return fmt.Errorf("unknown log level (%s)", level)
+	}
+	s.setLevel(l)
+	return nil
+}
+
+// setLevel 파일 로그 기록 레벨 변경 및 런타임 변경 여부 기록
+//
+// Parameters:
+//   - level: zapcore 로그 레벨
+func (s *SyncLogger) setLevel(level zapcore.Level) {
+	s.level.SetLevel(level)
+	s.levelChanged.Store(true)
+}
+
+// debugEnabled DEBUG 로그 기록 여부 판단
+//
+// Returns:
+//   - bool: 기록(true), 미기록(false)
+func (s *SyncLogger) debugEnabled() bool {
+	return config.RunConf.DebugMode || s.levelChanged.Load()
 }
 
 // newLumberJackLogger Lumberjack 생성
 //
 // Parameters:
 //   - logFilePath: 로그 파일 경로
+//   - conf: 로그 파일 관리 설정을 읽을 설정
 //
 // Returns:
 //   - *lumberjack.Logger
-func (s *SyncLogger) newLumberJackLogger(logFilePath string) *lumberjack.Logger {
+func (s *SyncLogger) newLumberJackLogger(logFilePath string, conf *config.Config) *lumberjack.Logger {
 	return &lumberjack.Logger{
 		Filename:   logFilePath,
-		MaxSize:    config.Conf.Log.MaxLogFileSize,
-		MaxBackups: config.Conf.Log.MaxLogFileBackup,
-		MaxAge:     config.Conf.Log.MaxLogFileAge,
-		Compress:   config.Conf.Log.CompBakLogFile,
+		MaxSize:    conf.Log.MaxLogFileSize,
+		MaxBackups: conf.Log.MaxLogFileBackup,
+		MaxAge:     conf.Log.MaxLogFileAge,
+		Compress:   conf.Log.CompBakLogFile,
 	}
 }
 
@@ -203,7 +442,7 @@ func (s *SyncLogger) LogError(format string, args ...interface{}) {
 //   - format: 로그 메시지
 //   - args: 가변 인자
 func (s *SyncLogger) LogDebug(format string, args ...interface{}) {
-	if config.RunConf.DebugMode {
+	if s.debugEnabled() {
 		message := fmt.Sprintf(format, args...)
 		s.zapLogger.Debug(message)
 	}
@@ -230,3 +469,87 @@ func (s *SyncLogger) LogFatal(format string, args ...interface{}) {
 	message := fmt.Sprintf(format, args...)
 	s.zapLogger.Fatal(message)
 }
+
+// LogInfow 키-값 필드를 포함한 로그 기록 (로그 레벨:INFO)
+//
+// Parameters:
+//   - msg: 로그 메시지
+//   - keysAndValues: 키, 값이 번갈아 오는 가변 인자 (ex: "client_ip", ip, "latency_ms", ms)
+func (s *SyncLogger) LogInfow(msg string, keysAndValues ...interface{}) {
+	s.sugar.Infow(msg, keysAndValues...)
+}
+
+// LogWarnw 키-값 필드를 포함한 로그 기록 (로그 레벨:WARN)
+//
+// Parameters:
+//   - msg: 로그 메시지
+//   - keysAndValues: 키, 값이 번갈아 오는 가변 인자
+func (s *SyncLogger) LogWarnw(msg string, keysAndValues ...interface{}) {
+	s.sugar.Warnw(msg, keysAndValues...)
+}
+
+// LogErrorw 키-값 필드를 포함한 로그 기록 (로그 레벨:ERROR)
+//
+// Parameters:
+//   - msg: 로그 메시지
+//   - keysAndValues: 키, 값이 번갈아 오는 가변 인자
+func (s *SyncLogger) LogErrorw(msg string, keysAndValues ...interface{}) {
+	s.sugar.Errorw(msg, keysAndValues...)
+}
+
+// LogDebugw 키-값 필드를 포함한 로그 기록 (로그 레벨:DEBUG)
+//
+// Parameters:
+//   - msg: 로그 메시지
+//   - keysAndValues: 키, 값이 번갈아 오는 가변 인자
+func (s *SyncLogger) LogDebugw(msg string, keysAndValues ...interface{}) {
+	if s.debugEnabled() {
+		s.sugar.Debugw(msg, keysAndValues...)
+	}
+}
+
+// fileWriter 로그 파일 관리 설정 변경을 위해 lumberjack 로거를 교체할 수 있는 Writer
+//
+// lumberjack 로거의 설정 필드는 동시 접근이 보호되지 않으므로 필드를 직접 수정하지 않고 로거를 교체함
+type fileWriter struct {
+	mu     sync.Mutex
+	logger *lumberjack.Logger
+}
+
+// Write 로그 파일에 기록
+//
+// Parameters:
+//   - p: 기록할 데이터
+//
+// Returns:
+//   - int: 기록한 바이트 수
+//   - error: 성공(nil), 실패(error)
+func (w *fileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.logger.Write(p)
+}
+
+// Close 로그 파일 닫기 (다음 기록 시 다시 열림)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (w *fileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.logger.Close()
+}
+
+// swap lumberjack 로거 교체 (기존 로거의 로그 파일은 닫힘)
+//
+// Parameters:
+//   - logger: 새 lumberjack 로거
+func (w *fileWriter) swap(logger *lumberjack.Logger) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.logger.Close()
+	w.logger = logger
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/meloncoffee/weblin/config"
+)
+
+// chdirTemp 임시 디렉터리로 작업 경로 변경 (테스트 종료 시 복구)
+//
+// 로그 파일 경로(config.LogFilePath)는 작업 경로 기준 상대 경로이므로 테스트마다 분리
+//
+// Parameters:
+//   - t: 테스트 상태
+func chdirTemp(t *testing.T) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+// TestReopen 외부 logrotate가 로그 파일을 이동시킨 후 Reopen하면
+// 설정된 경로에 새 로그 파일이 생성되어 기록되는지 확인
+func TestReopen(t *testing.T) {
+	chdirTemp(t)
+
+	s := &SyncLogger{}
+	if err := s.InitializeLogger(); err != nil {
+		t.Fatalf("InitializeLogger failed: %v", err)
+	}
+	defer s.FinalizeLogger()
+
+	s.LogInfo("before rotate")
+	s.zapLogger.Sync()
+
+	// logrotate와 같이 로그 파일 이동
+	rotated := config.LogFilePath + ".1"
+	if err := os.Rename(config.LogFilePath, rotated); err != nil {
+		t.Fatalf("failed to rename log file: %v", err)
+	}
+
+	// 이동 후 Reopen 전의 로그는 이동된 파일(기존 inode)에 기록됨
+	s.LogInfo("after rotate")
+	if err := s.Reopen(); err != nil {
+		t.Fatalf("Reopen failed: %v", err)
+	}
+	s.LogInfo("after reopen")
+	s.zapLogger.Sync()
+
+	current, err := os.ReadFile(config.LogFilePath)
+	if err != nil {
+		t.Fatalf("log file was not recreated at %s: %v", config.LogFilePath, err)
+	}
+	if !strings.Contains(string(current), "after reopen") {
+		t.Errorf("new log file does not contain the message written after Reopen:\n%s", current)
+	}
+	if strings.Contains(string(current), "before rotate") || strings.Contains(string(current), "after rotate") {
+		t.Errorf("new log file contains messages written before Reopen:\n%s", current)
+	}
+
+	old, err := os.ReadFile(rotated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(old), "after rotate") {
+		t.Errorf("rotated log file does not contain the message written before Reopen:\n%s", old)
+	}
+}
+
+// TestInitializeLoggerDirError 로그 디렉터리를 생성할 수 없으면 InitializeLogger가 에러를 반환하는지 확인
+func TestInitializeLoggerDirError(t *testing.T) {
+	chdirTemp(t)
+
+	// 로그 디렉터리 경로에 일반 파일 생성
+	if err := os.WriteFile(filepath.Dir(config.LogFilePath), nil, 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	s := &SyncLogger{}
+	err := s.InitializeLogger()
+	if err == nil {
+		s.FinalizeLogger()
+		t.Fatal("InitializeLogger succeeded without a log directory")
+	}
+	if !strings.Contains(err.Error(), "failed to create log directory") {
+		t.Fatalf("InitializeLogger error = %v", err)
+	}
+}
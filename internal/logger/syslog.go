@@ -0,0 +1,133 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package logger
+
+import (
+	"log/syslog"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// syslogCore syslog로 로그를 전송하는 zapcore.Core 구현 구조체
+type syslogCore struct {
+	zapcore.LevelEnabler
+	encoder zapcore.Encoder
+	writer  *syslog.Writer
+}
+
+// newSyslogCore syslog 코어 생성
+//
+// Parameters:
+//   - network: 접속 네트워크 (빈 문자열이면 로컬 syslog 소켓, ex: udp, tcp)
+//   - address: 접속 주소 (network가 빈 문자열이면 무시)
+//   - tag: syslog 태그
+//   - encoder: 로그 인코더
+//   - enabler: 로그 레벨 활성화 여부 판단
+//
+// Returns:
+//   - zapcore.Core: syslog 코어
+//   - error: 성공(nil), 실패(error)
+func newSyslogCore(network, address, tag string, encoder zapcore.Encoder,
+	enabler zapcore.LevelEnabler) (zapcore.Core, error) {
+	writer, err := syslog.Dial(network, address, syslog.LOG_DAEMON|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogCore{
+		LevelEnabler: enabler,
+		encoder:      encoder,
+		writer:       writer,
+	}, nil
+}
+
+// With 필드가 추가된 코어 반환
+//
+// Parameters:
+//   - fields: 추가할 필드 리스트
+//
+// Returns:
+//   - zapcore.Core: 필드가 추가된 코어
+func (c *syslogCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &syslogCore{
+		LevelEnabler: c.LevelEnabler,
+		encoder:      c.encoder.Clone(),
+		writer:       c.writer,
+	}
+	for _, field := range fields {
+		field.AddTo(clone.encoder)
+	}
+	return clone
+}
+
+// Check 로그 레벨이 활성화되어 있으면 기록 대상에 코어 추가
+//
+// Parameters:
+//   - entry: 로그 엔트리
+//   - ce: 기록 대상 엔트리
+//
+// Returns:
+//   - *zapcore.CheckedEntry: 기록 대상 엔트리
+func (c *syslogCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+// Write 로그 엔트리를 인코딩하여 로그 레벨에 맞는 syslog 우선순위로 전송
+//
+// Parameters:
+//   - entry: 로그 엔트리
+//   - fields: 로그 필드 리스트
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (c *syslogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.encoder.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	message := strings.TrimSuffix(buf.String(), "\n")
+	buf.Free()
+
+	switch entry.Level {
+	case zapcore.DebugLevel:
+		return c.writer.Debug(message)
+	case zapcore.InfoLevel:
+		return c.writer.Info(message)
+	case zapcore.WarnLevel:
+		return c.writer.Warning(message)
+	case zapcore.ErrorLevel:
+		return c.writer.Err(message)
+	case zapcore.DPanicLevel, zapcore.PanicLevel:
+		return c.writer.Crit(message)
+	case zapcore.FatalLevel:
+		return c.writer.Emerg(message)
+	default:
+		return c.writer.Info(message)
+	}
+}
+
+// Sync syslog는 버퍼링하지 않으므로 처리할 내용 없음
+//
+// Returns:
+//   - error: 항상 nil
+func (c *syslogCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 JongHoon Shim and The unisys Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package metric
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTPMetrics HTTP 요청 메트릭 구조체
+type HTTPMetrics struct {
+	requests *prometheus.CounterVec // 상태 코드 별 요청 수
+	duration prometheus.Histogram   // 요청 처리 시간 분포
+}
+
+// NewHTTPMetrics HTTPMetrics 구조체 초기화 및 생성
+//
+// Returns:
+//   - *HTTPMetrics: 초기화된 HTTPMetrics 구조체
+func NewHTTPMetrics() *HTTPMetrics {
+	d := newDescFactory()
+
+	return &HTTPMetrics{
+		requests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        d.namespace + "http_requests_total",
+				Help:        d.help("http_requests_total", "Total number of HTTP requests per status code"),
+				ConstLabels: d.constLabels,
+			},
+			[]string{"code"},
+		),
+		duration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        d.namespace + "http_request_duration_seconds",
+				Help:        d.help("http_request_duration_seconds", "HTTP request latency in seconds"),
+				ConstLabels: d.constLabels,
+				Buckets:     prometheus.DefBuckets,
+			},
+		),
+	}
+}
+
+// Observe 처리가 완료된 HTTP 요청 기록
+//
+// Parameters:
+//   - status: 응답 상태 코드
+//   - elapsed: 요청 처리 시간
+func (h *HTTPMetrics) Observe(status int, elapsed time.Duration) {
+	h.requests.WithLabelValues(strconv.Itoa(status)).Inc()
+	h.duration.Observe(elapsed.Seconds())
+}
+
+// Describe Prometheus Collector 인터페이스의 필수 메서드로,
+// 수집기(collector)가 제공할 수 있는 메트릭을 사전에 정의
+//
+// Parameters:
+//   - ch: Prometheus가 메트릭의 정의를 수집할 때 사용하는 채널
+func (h *HTTPMetrics) Describe(ch chan<- *prometheus.Desc) {
+	h.requests.Describe(ch)
+	h.duration.Describe(ch)
+}
+
+// Collect Prometheus Collector 인터페이스의 필수 메서드로,
+// 누적된 HTTP 요청 메트릭 전달
+//
+// Parameters:
+//   - ch: Prometheus가 메트릭 데이터를 수집할 때 사용하는 채널
+func (h *HTTPMetrics) Collect(ch chan<- prometheus.Metric) {
+	h.requests.Collect(ch)
+	h.duration.Collect(ch)
+}
@@ -20,18 +20,70 @@ Package metric 메트릭 패키지
 package metric
 
 import (
+	"os"
+	"slices"
+	"time"
+
+	"github.com/meloncoffee/weblin/config"
+	"github.com/meloncoffee/weblin/internal/logger"
+	"github.com/meloncoffee/weblin/pkg/utils/goroutine"
+	"github.com/meloncoffee/weblin/pkg/utils/resource"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-const namespace = "weblin_"
+// descFactory 네임스페이스와 고정 라벨을 적용하여 메트릭 Desc를 생성하는 구조체
+type descFactory struct {
+	namespace   string            // 메트릭명 접두사
+	constLabels prometheus.Labels // 모든 메트릭에 적용되는 고정 라벨
+}
 
 // Metrics Prometheus와 연동하기 위한 구조체
 type Metrics struct {
-	CPUUsageRate  *prometheus.Desc
-	MemUsageRate  *prometheus.Desc
-	DiskUsageRate *prometheus.Desc
-	NetworkInBps  *prometheus.Desc
-	NetworkOutBps *prometheus.Desc
+	CPUUsageRate   *prometheus.Desc
+	CPUModeRate    *prometheus.Desc
+	MemUsageRate   *prometheus.Desc
+	MemBuffers     *prometheus.Desc
+	MemCached      *prometheus.Desc
+	SwapTotal      *prometheus.Desc
+	SwapFree       *prometheus.Desc
+	DiskUsageRate  *prometheus.Desc
+	NetworkInBps   *prometheus.Desc
+	NetworkOutBps  *prometheus.Desc
+	NetworkRxErrs  *prometheus.Desc
+	NetworkRxDrops *prometheus.Desc
+	NetworkTxErrs  *prometheus.Desc
+	NetworkTxDrops *prometheus.Desc
+	NetworkRxBytes *prometheus.Desc
+	NetworkTxBytes *prometheus.Desc
+	TCPRetransmits *prometheus.Desc
+	TCPActiveOpens *prometheus.Desc
+	UDPErrors      *prometheus.Desc
+	TCPConnections *prometheus.Desc
+	Softirqs       *prometheus.Desc
+	OOMKills       *prometheus.Desc
+	CPUThrottles   *prometheus.Desc
+	CPUTemperature *prometheus.Desc
+	ConfigAge      *prometheus.Desc
+	Uptime         *prometheus.Desc
+	ProcsRunning   *prometheus.Desc
+	ProcsTotal     *prometheus.Desc
+	CPUUsagePeak   *prometheus.Desc
+	MemUsagePeak   *prometheus.Desc
+	DiskUsagePeak  *prometheus.Desc
+	NetworkInPeak  *prometheus.Desc
+	NetworkOutPeak *prometheus.Desc
+	CollectorUp    *prometheus.Desc
+	TaskUp         *prometheus.Desc
+	TaskRestarts   *prometheus.Desc
+	OpenFDs        *prometheus.Desc
+	MaxFDs         *prometheus.Desc
+
+	// 작업 상태를 조회할 고루틴 관리 구조체 (nil이면 작업 메트릭 생략)
+	Tasks *goroutine.GoroutineManager
+	// 커널 링 버퍼 OOM kill 메시지 감시
+	oomWatcher *resource.OOMWatcher
+	// 스크래핑 시점 리소스 사용률 계산 (collectOnScrape 모드)
+	usageCollector *resource.UsageCollector
 }
 
 // NewMetrics Metrics 구조체 초기화 및 생성
@@ -39,39 +91,278 @@ type Metrics struct {
 // Returns:
 //   - Metrics: 초기화된 Metrics 구조체
 func NewMetrics() Metrics {
+	d := newDescFactory()
+
 	m := Metrics{
-		CPUUsageRate: prometheus.NewDesc(
-			namespace+"cpu_usage_rate",
+		CPUUsageRate: d.newDesc(
+			"cpu_usage_rate",
 			"Current CPU usage in percentage",
-			nil, nil,
+			nil,
+		),
+		CPUModeRate: d.newDesc(
+			"cpu_mode_rate",
+			"Current CPU usage in percentage per mode",
+			[]string{"mode"},
 		),
-		MemUsageRate: prometheus.NewDesc(
-			namespace+"memory_usage_rate",
+		MemUsageRate: d.newDesc(
+			"memory_usage_rate",
 			"Current memory usage in percentage",
-			nil, nil,
+			nil,
+		),
+		MemBuffers: d.newDesc(
+			"memory_buffers_bytes",
+			"Memory used by kernel I/O buffers in bytes",
+			nil,
+		),
+		MemCached: d.newDesc(
+			"memory_cached_bytes",
+			"Memory used by the page cache in bytes",
+			nil,
+		),
+		SwapTotal: d.newDesc(
+			"memory_swap_total_bytes",
+			"Total swap space in bytes",
+			nil,
+		),
+		SwapFree: d.newDesc(
+			"memory_swap_free_bytes",
+			"Unused swap space in bytes",
+			nil,
 		),
-		DiskUsageRate: prometheus.NewDesc(
-			namespace+"disk_usage_rate",
-			"Current disk usage in percentage",
-			nil, nil,
+		DiskUsageRate: d.newDesc(
+			"disk_usage_rate",
+			"Current disk usage in percentage per monitored path",
+			[]string{"mount"},
 		),
-		NetworkInBps: prometheus.NewDesc(
-			namespace+"network_inbound_bps",
+		NetworkInBps: d.newDesc(
+			"network_inbound_bps",
 			"Current network inbound traffic in bps for all interfaces",
 			[]string{"interface"},
-			nil,
 		),
-		NetworkOutBps: prometheus.NewDesc(
-			namespace+"network_outbound_bps",
+		NetworkOutBps: d.newDesc(
+			"network_outbound_bps",
 			"Current network outbound traffic in bps for all interfaces",
 			[]string{"interface"},
+		),
+		NetworkRxErrs: d.newDesc(
+			"network_receive_errors_total",
+			"Total number of receive errors per interface",
+			[]string{"interface"},
+		),
+		NetworkRxDrops: d.newDesc(
+			"network_receive_dropped_total",
+			"Total number of received packets dropped per interface",
+			[]string{"interface"},
+		),
+		NetworkTxErrs: d.newDesc(
+			"network_transmit_errors_total",
+			"Total number of transmit errors per interface",
+			[]string{"interface"},
+		),
+		NetworkTxDrops: d.newDesc(
+			"network_transmit_dropped_total",
+			"Total number of transmitted packets dropped per interface",
+			[]string{"interface"},
+		),
+		NetworkRxBytes: d.newDesc(
+			"network_receive_bytes_total",
+			"Total number of bytes received per interface since boot",
+			[]string{"interface"},
+		),
+		NetworkTxBytes: d.newDesc(
+			"network_transmit_bytes_total",
+			"Total number of bytes transmitted per interface since boot",
+			[]string{"interface"},
+		),
+		TCPRetransmits: d.newDesc(
+			"tcp_retransmits_total",
+			"Total number of TCP segments retransmitted",
 			nil,
 		),
+		TCPActiveOpens: d.newDesc(
+			"tcp_active_opens_total",
+			"Total number of TCP connections actively opened",
+			nil,
+		),
+		TCPConnections: d.newDesc(
+			"tcp_connections",
+			"Current number of TCP sockets per connection state",
+			[]string{"state"},
+		),
+		UDPErrors: d.newDesc(
+			"udp_errors_total",
+			"Total number of UDP datagrams received with errors",
+			nil,
+		),
+		Softirqs: d.newDesc(
+			"softirqs_total",
+			"Total number of softirqs handled per CPU and type",
+			[]string{"cpu", "type"},
+		),
+		OOMKills: d.newDesc(
+			"oom_kills_total",
+			"Total number of processes killed by the OOM killer",
+			nil,
+		),
+		CPUThrottles: d.newDesc(
+			"cpu_throttle_count_total",
+			"Total number of thermal throttling events per CPU core",
+			[]string{"core"},
+		),
+		CPUTemperature: d.newDesc(
+			"cpu_temperature_celsius",
+			"Current temperature in degrees Celsius per hwmon sensor",
+			[]string{"sensor"},
+		),
+		ConfigAge: d.newDesc(
+			"config_age_seconds",
+			"Time in seconds since the active configuration was loaded",
+			nil,
+		),
+		Uptime: d.newDesc(
+			"uptime_seconds",
+			"Time in seconds since the system booted",
+			nil,
+		),
+		ProcsRunning: d.newDesc(
+			"procs_running",
+			"Number of processes in runnable state",
+			nil,
+		),
+		ProcsTotal: d.newDesc(
+			"procs_total",
+			"Total number of processes",
+			nil,
+		),
+		CPUUsagePeak: d.newDesc(
+			"cpu_usage_rate_peak",
+			"Peak CPU usage in percentage over the configured window",
+			nil,
+		),
+		MemUsagePeak: d.newDesc(
+			"memory_usage_rate_peak",
+			"Peak memory usage in percentage over the configured window",
+			nil,
+		),
+		DiskUsagePeak: d.newDesc(
+			"disk_usage_rate_peak",
+			"Peak disk usage in percentage per monitored path over the configured window",
+			[]string{"mount"},
+		),
+		NetworkInPeak: d.newDesc(
+			"network_inbound_bps_peak",
+			"Peak network inbound traffic in bps over the configured window",
+			[]string{"interface"},
+		),
+		NetworkOutPeak: d.newDesc(
+			"network_outbound_bps_peak",
+			"Peak network outbound traffic in bps over the configured window",
+			[]string{"interface"},
+		),
+		CollectorUp: d.newDesc(
+			"collector_enabled",
+			"Whether a collector is enabled by configuration (1: enabled, 0: disabled)",
+			[]string{"collector"},
+		),
+		TaskUp: d.newDesc(
+			"task_up",
+			"Whether a background task is running (1: running, 0: stopped)",
+			[]string{"task"},
+		),
+		TaskRestarts: d.newDesc(
+			"task_restarts_total",
+			"Total number of times a background task was restarted after a panic",
+			[]string{"task"},
+		),
+		OpenFDs: d.newDesc(
+			"process_open_fds",
+			"Number of open file descriptors of the weblin process",
+			nil,
+		),
+		MaxFDs: d.newDesc(
+			"process_max_fds",
+			"Maximum number of open file descriptors of the weblin process (soft limit)",
+			nil,
+		),
+		oomWatcher: &resource.OOMWatcher{},
+		usageCollector: &resource.UsageCollector{
+			DiskPaths:         config.Conf.Metric.DiskPaths,
+			ExcludeInterfaces: config.Conf.Metric.ExcludeInterfaces,
+		},
 	}
 
 	return m
 }
 
+// newDescFactory 설정 파일 기준으로 메트릭 Desc 생성 구조체 생성
+//
+// hostname 고정 라벨이 설정되어 있지 않으면 os.Hostname()으로 자동 설정
+//
+// Returns:
+//   - descFactory
+func newDescFactory() descFactory {
+	constLabels := make(prometheus.Labels, len(config.Conf.Metric.ConstLabels)+1)
+	for name, value := range config.Conf.Metric.ConstLabels {
+		constLabels[name] = value
+	}
+	if _, ok := constLabels["hostname"]; !ok {
+		if hostname, err := os.Hostname(); err == nil {
+			constLabels["hostname"] = hostname
+		}
+	}
+
+	return descFactory{
+		namespace:   config.Conf.Metric.Namespace,
+		constLabels: constLabels,
+	}
+}
+
+// help 메트릭 help 문자열 반환 (helpOverrides에 재정의되어 있으면 재정의된 문자열 사용)
+//
+// Parameters:
+//   - name: 네임스페이스를 제외한 메트릭명
+//   - help: 기본 help 문자열
+//
+// Returns:
+//   - string: help 문자열
+func (d descFactory) help(name, help string) string {
+	if override, ok := config.Conf.Metric.HelpOverrides[name]; ok && override != "" {
+		return override
+	}
+	return help
+}
+
+// newDesc 메트릭 Desc 생성
+//
+// 설정 파일에 메트릭 help 문자열 재정의(helpOverrides)가 있으면 기본 help 문자열 대신 사용.
+// 가변 라벨과 이름이 같은 고정 라벨은 Desc 생성 에러를 피하기 위해 해당 메트릭에서 제외.
+//
+// Parameters:
+//   - name: 네임스페이스를 제외한 메트릭명
+//   - help: 기본 help 문자열
+//   - labels: 가변 라벨명 리스트
+//
+// Returns:
+//   - *prometheus.Desc: 메트릭 Desc
+func (d descFactory) newDesc(name, help string, labels []string) *prometheus.Desc {
+	help = d.help(name, help)
+
+	constLabels := d.constLabels
+	for _, label := range labels {
+		if _, ok := constLabels[label]; ok {
+			constLabels = make(prometheus.Labels, len(d.constLabels))
+			for k, v := range d.constLabels {
+				if !slices.Contains(labels, k) {
+					constLabels[k] = v
+				}
+			}
+			break
+		}
+	}
+
+	return prometheus.NewDesc(d.namespace+name, help, labels, constLabels)
+}
+
 // Describe Prometheus Collector 인터페이스의 필수 메서드로,
 // 수집기(collector)가 제공할 수 있는 메트릭을 사전에 정의
 //
@@ -79,10 +370,43 @@ func NewMetrics() Metrics {
 //   - ch: Prometheus가 메트릭의 정의를 수집할 때 사용하는 채널
 func (m Metrics) Describe(ch chan<- *prometheus.Desc) {
 	ch <- m.CPUUsageRate
+	ch <- m.CPUModeRate
 	ch <- m.MemUsageRate
+	ch <- m.MemBuffers
+	ch <- m.MemCached
+	ch <- m.SwapTotal
+	ch <- m.SwapFree
 	ch <- m.DiskUsageRate
 	ch <- m.NetworkInBps
 	ch <- m.NetworkOutBps
+	ch <- m.NetworkRxErrs
+	ch <- m.NetworkRxDrops
+	ch <- m.NetworkTxErrs
+	ch <- m.NetworkTxDrops
+	ch <- m.NetworkRxBytes
+	ch <- m.NetworkTxBytes
+	ch <- m.TCPRetransmits
+	ch <- m.TCPActiveOpens
+	ch <- m.UDPErrors
+	ch <- m.TCPConnections
+	ch <- m.Softirqs
+	ch <- m.OOMKills
+	ch <- m.CPUThrottles
+	ch <- m.CPUTemperature
+	ch <- m.ConfigAge
+	ch <- m.Uptime
+	ch <- m.ProcsRunning
+	ch <- m.ProcsTotal
+	ch <- m.CPUUsagePeak
+	ch <- m.MemUsagePeak
+	ch <- m.DiskUsagePeak
+	ch <- m.NetworkInPeak
+	ch <- m.NetworkOutPeak
+	ch <- m.CollectorUp
+	ch <- m.TaskUp
+	ch <- m.TaskRestarts
+	ch <- m.OpenFDs
+	ch <- m.MaxFDs
 }
 
 // Collect Prometheus Collector 인터페이스의 필수 메서드로,
@@ -91,31 +415,89 @@ func (m Metrics) Describe(ch chan<- *prometheus.Desc) {
 // Parameters:
 //   - ch: Prometheus가 메트릭 데이터를 수집할 때 사용하는 채널
 func (m Metrics) Collect(ch chan<- prometheus.Metric) {
+	var usage resource.Usage
+	if config.Conf.Metric.CollectOnScrape {
+		// 스크래핑 시점에 리소스 사용률 계산
+		var err error
+		usage, err = m.usageCollector.Collect()
+		if err != nil {
+			logger.Log.LogDebug("Failed to collect resource usage: %v", err)
+		}
+		// 최대값 추적 등에 반영되도록 계산된 리소스 사용률 갱신
+		resource.SetUsage(usage)
+	} else {
+		// 가장 최근에 계산된 리소스 사용률 획득
+		usage = resource.GetUsage()
+	}
 
 	// CPU 사용률 메트릭 수집
-	ch <- prometheus.MustNewConstMetric(
+	m.emit(
+		ch,
 		m.CPUUsageRate,
 		prometheus.GaugeValue,
-		resource.CPUUsageRate,
+		usage.CPUUsageRate,
 	)
+	// CPU 모드 별 사용률 메트릭 수집
+	for mode, rate := range usage.CPUModeRates {
+		m.emit(
+			ch,
+			m.CPUModeRate,
+			prometheus.GaugeValue,
+			rate,
+			mode,
+		)
+	}
 	// Memory 사용률 메트릭 수집
-	ch <- prometheus.MustNewConstMetric(
+	m.emit(
+		ch,
 		m.MemUsageRate,
 		prometheus.GaugeValue,
-		resource.MemUsageRate,
-	)
-	// Disk 사용률 메트릭 수집
-	ch <- prometheus.MustNewConstMetric(
-		m.DiskUsageRate,
-		prometheus.GaugeValue,
-		resource.DiskUsageRate,
+		usage.MemUsageRate,
 	)
+	// Memory 상세 사용량 메트릭 수집 (/proc/meminfo의 kbyte 단위를 byte 단위로 변환)
+	if memStat, err := resource.GetMemStat(); err == nil {
+		m.emit(
+			ch,
+			m.MemBuffers,
+			prometheus.GaugeValue,
+			float64(memStat.Buffers*1024),
+		)
+		m.emit(
+			ch,
+			m.MemCached,
+			prometheus.GaugeValue,
+			float64(memStat.Cached*1024),
+		)
+		m.emit(
+			ch,
+			m.SwapTotal,
+			prometheus.GaugeValue,
+			float64(memStat.SwapTotal*1024),
+		)
+		m.emit(
+			ch,
+			m.SwapFree,
+			prometheus.GaugeValue,
+			float64(memStat.SwapFree*1024),
+		)
+	}
+	// Disk 사용률 메트릭 수집 (경로별)
+	for path, rate := range usage.DiskUsageRates {
+		m.emit(
+			ch,
+			m.DiskUsageRate,
+			prometheus.GaugeValue,
+			rate,
+			path,
+		)
+	}
 
-	if len(resource.NetworkTraffic) > 0 {
+	if len(usage.NetworkTraffic) > 0 {
 		// 네트워크 트래픽 메트릭 수집 (인터페이스별)
-		for _, traffic := range resource.NetworkTraffic {
+		for _, traffic := range usage.NetworkTraffic {
 			// 네트워크 Inbound 트래픽 메트릭 수집
-			ch <- prometheus.MustNewConstMetric(
+			m.emit(
+				ch,
 				m.NetworkInBps,
 				prometheus.GaugeValue,
 				traffic.InboundBps,
@@ -123,25 +505,381 @@ func (m Metrics) Collect(ch chan<- prometheus.Metric) {
 			)
 
 			// 네트워크 Outbound 트래픽 메트릭 수집
-			ch <- prometheus.MustNewConstMetric(
+			m.emit(
+				ch,
 				m.NetworkOutBps,
 				prometheus.GaugeValue,
 				traffic.OutboundBps,
 				traffic.Interface, // 라벨 값으로 인터페이스 이름 전달
 			)
+
+			// 네트워크 에러 및 드롭 카운터 메트릭 수집
+			m.emit(
+				ch,
+				m.NetworkRxErrs,
+				prometheus.CounterValue,
+				float64(traffic.RxErrors),
+				traffic.Interface,
+			)
+			m.emit(
+				ch,
+				m.NetworkRxDrops,
+				prometheus.CounterValue,
+				float64(traffic.RxDropped),
+				traffic.Interface,
+			)
+			m.emit(
+				ch,
+				m.NetworkTxErrs,
+				prometheus.CounterValue,
+				float64(traffic.TxErrors),
+				traffic.Interface,
+			)
+			m.emit(
+				ch,
+				m.NetworkTxDrops,
+				prometheus.CounterValue,
+				float64(traffic.TxDropped),
+				traffic.Interface,
+			)
+
+			// 네트워크 누적 송수신 바이트 카운터 메트릭 수집 (rate() 계산용)
+			m.emit(
+				ch,
+				m.NetworkRxBytes,
+				prometheus.CounterValue,
+				float64(traffic.RxBytes),
+				traffic.Interface,
+			)
+			m.emit(
+				ch,
+				m.NetworkTxBytes,
+				prometheus.CounterValue,
+				float64(traffic.TxBytes),
+				traffic.Interface,
+			)
 		}
 	} else {
-		ch <- prometheus.MustNewConstMetric(
+		m.emit(
+			ch,
 			m.NetworkInBps,
 			prometheus.GaugeValue,
 			float64(0.0),
 			"unknown",
 		)
-		ch <- prometheus.MustNewConstMetric(
+		m.emit(
+			ch,
 			m.NetworkOutBps,
 			prometheus.GaugeValue,
 			float64(0.0),
 			"unknown",
 		)
 	}
+
+	// 수집기 활성화 여부 메트릭 수집
+	for name, enabled := range m.collectorStates() {
+		value := 0.0
+		if enabled {
+			value = 1.0
+		}
+		m.emit(
+			ch,
+			m.CollectorUp,
+			prometheus.GaugeValue,
+			value,
+			name,
+		)
+	}
+
+	// 리소스 사용률 최대값 메트릭 수집
+	m.collectPeaks(ch)
+
+	// 백그라운드 작업 상태 메트릭 수집
+	m.collectTasks(ch)
+
+	// 프로토콜 계층 카운터 메트릭 수집 (/proc/net/snmp)
+	if snmp, err := resource.GetSNMPStats(); err == nil {
+		m.emit(
+			ch,
+			m.TCPRetransmits,
+			prometheus.CounterValue,
+			float64(snmp.TCPRetransSegs),
+		)
+		m.emit(
+			ch,
+			m.TCPActiveOpens,
+			prometheus.CounterValue,
+			float64(snmp.TCPActiveOpens),
+		)
+		m.emit(
+			ch,
+			m.UDPErrors,
+			prometheus.CounterValue,
+			float64(snmp.UDPInErrors),
+		)
+	}
+
+	// TCP 연결 상태 별 소켓 수 메트릭 수집
+	if tcpStats, err := resource.GetTCPStats(); err == nil {
+		for state, count := range tcpStats {
+			m.emit(
+				ch,
+				m.TCPConnections,
+				prometheus.GaugeValue,
+				float64(count),
+				state,
+			)
+		}
+	}
+
+	// CPU 별 softirq 메트릭 수집 (opt-in)
+	if config.Conf.Metric.EnableSoftirqs {
+		if softirq, err := resource.GetSoftirqStat(); err == nil {
+			for irqType, counts := range softirq.Counts {
+				for i, count := range counts {
+					m.emit(
+						ch,
+						m.Softirqs,
+						prometheus.CounterValue,
+						float64(count),
+						softirq.CPUs[i], irqType,
+					)
+				}
+			}
+		}
+	}
+
+	// OOM kill 메트릭 수집
+	m.collectOOMKills(ch)
+
+	// CPU 코어 별 열 스로틀링 메트릭 수집 (thermal_throttle 미제공 환경은 생략)
+	if throttles, err := resource.GetCPUThrottleCounts(); err == nil {
+		for _, throttle := range throttles {
+			m.emit(
+				ch,
+				m.CPUThrottles,
+				prometheus.CounterValue,
+				float64(throttle.Count),
+				throttle.Core,
+			)
+		}
+	}
+
+	// hwmon 센서 별 온도 메트릭 수집 (hwmon 센서가 없는 환경은 생략)
+	if temps, err := resource.GetCPUTemperature(); err == nil {
+		for _, temp := range temps {
+			m.emit(
+				ch,
+				m.CPUTemperature,
+				prometheus.GaugeValue,
+				temp.Celsius,
+				temp.Label,
+			)
+		}
+	}
+
+	// 설정 로드 이후 경과 시간 메트릭 수집
+	if loadTime := config.LoadTime(); !loadTime.IsZero() {
+		m.emit(
+			ch,
+			m.ConfigAge,
+			prometheus.GaugeValue,
+			time.Since(loadTime).Seconds(),
+		)
+	}
+
+	// 시스템 가동 시간 메트릭 수집
+	if uptime, err := resource.GetUptime(); err == nil {
+		m.emit(
+			ch,
+			m.Uptime,
+			prometheus.GaugeValue,
+			uptime.Seconds(),
+		)
+	}
+
+	// 프로세스 수 메트릭 수집
+	if running, total, err := resource.GetProcessCount(); err == nil {
+		m.emit(
+			ch,
+			m.ProcsRunning,
+			prometheus.GaugeValue,
+			float64(running),
+		)
+		m.emit(
+			ch,
+			m.ProcsTotal,
+			prometheus.GaugeValue,
+			float64(total),
+		)
+	}
+
+	// 파일 디스크립터 사용량 메트릭 수집
+	if open, max, err := resource.GetFDStat(); err == nil {
+		m.emit(
+			ch,
+			m.OpenFDs,
+			prometheus.GaugeValue,
+			float64(open),
+		)
+		m.emit(
+			ch,
+			m.MaxFDs,
+			prometheus.GaugeValue,
+			float64(max),
+		)
+	}
+}
+
+// collectorStates 수집기 별 활성화 여부 반환
+//
+// 비활성화된 수집기의 메트릭이 없는 것과 수집 실패로 메트릭이 없는 것을 구분하기 위해 사용
+//
+// Returns:
+//   - map[string]bool: 수집기명 별 활성화 여부
+func (m Metrics) collectorStates() map[string]bool {
+	return map[string]bool{
+		"cpu":              true,
+		"memory":           true,
+		"disk":             true,
+		"network":          true,
+		"peak":             true,
+		"snmp":             true,
+		"tcp_states":       true,
+		"softirqs":         config.Conf.Metric.EnableSoftirqs,
+		"oom":              true,
+		"thermal_throttle": true,
+		"temperature":      true,
+		"task":             m.Tasks != nil,
+		"fd":               true,
+	}
+}
+
+// collectTasks 백그라운드 작업 가동 여부 및 재시작 횟수 메트릭 수집
+//
+// Parameters:
+//   - ch: Prometheus가 메트릭 데이터를 수집할 때 사용하는 채널
+func (m Metrics) collectTasks(ch chan<- prometheus.Metric) {
+	if m.Tasks == nil {
+		return
+	}
+
+	for _, name := range m.Tasks.ListTasks() {
+		up := 0.0
+		if m.Tasks.IsRunning(name) {
+			up = 1.0
+		}
+		m.emit(
+			ch,
+			m.TaskUp,
+			prometheus.GaugeValue,
+			up,
+			name,
+		)
+		m.emit(
+			ch,
+			m.TaskRestarts,
+			prometheus.CounterValue,
+			float64(m.Tasks.Restarts(name)),
+			name,
+		)
+	}
+}
+
+// collectPeaks 리소스 사용률 최대값 메트릭 수집
+//
+// Parameters:
+//   - ch: Prometheus가 메트릭 데이터를 수집할 때 사용하는 채널
+func (m Metrics) collectPeaks(ch chan<- prometheus.Metric) {
+	peak := resource.GetPeakUsage()
+
+	m.emit(
+		ch,
+		m.CPUUsagePeak,
+		prometheus.GaugeValue,
+		peak.CPUUsageRate,
+	)
+	m.emit(
+		ch,
+		m.MemUsagePeak,
+		prometheus.GaugeValue,
+		peak.MemUsageRate,
+	)
+	for path, rate := range peak.DiskUsageRates {
+		m.emit(
+			ch,
+			m.DiskUsagePeak,
+			prometheus.GaugeValue,
+			rate,
+			path,
+		)
+	}
+	for _, traffic := range peak.NetworkTraffic {
+		m.emit(
+			ch,
+			m.NetworkInPeak,
+			prometheus.GaugeValue,
+			traffic.InboundBps,
+			traffic.Interface,
+		)
+		m.emit(
+			ch,
+			m.NetworkOutPeak,
+			prometheus.GaugeValue,
+			traffic.OutboundBps,
+			traffic.Interface,
+		)
+	}
+}
+
+// collectOOMKills OOM kill 메트릭 수집
+//
+// 커널 링 버퍼에서 새로 확인된 OOM kill은 종료된 프로세스 정보와 함께 로그로 기록.
+// 메트릭 값은 /proc/vmstat의 oom_kill 카운터를 우선 사용하고,
+// 해당 카운터가 없는 커널에서는 감시 시작 이후 확인된 횟수를 사용.
+//
+// Parameters:
+//   - ch: Prometheus가 메트릭 데이터를 수집할 때 사용하는 채널
+func (m Metrics) collectOOMKills(ch chan<- prometheus.Metric) {
+	events, err := m.oomWatcher.Poll()
+	if err != nil {
+		logger.Log.LogDebug("Failed to read kernel ring buffer: %v", err)
+	}
+	for _, event := range events {
+		logger.Log.LogWarn("OOM killer fired (pid: %d, process: %s)", event.Pid, event.Name)
+	}
+
+	kills, err := resource.GetOOMKillCount()
+	if err != nil {
+		kills = m.oomWatcher.Kills()
+	}
+
+	m.emit(
+		ch,
+		m.OOMKills,
+		prometheus.CounterValue,
+		float64(kills),
+	)
+}
+
+// emit 메트릭 생성 및 전송
+//
+// 라벨 개수 불일치 등으로 메트릭 생성 중 패닉이 발생하면 해당 메트릭만 생략하여
+// 하나의 잘못된 메트릭 때문에 전체 스크래핑이 실패하지 않도록 함
+//
+// Parameters:
+//   - ch: Prometheus가 메트릭 데이터를 수집할 때 사용하는 채널
+//   - desc: 메트릭 Desc
+//   - valueType: 메트릭 값 타입
+//   - value: 메트릭 값
+//   - labelValues: 가변 라벨 값
+func (m Metrics) emit(ch chan<- prometheus.Metric, desc *prometheus.Desc,
+	valueType prometheus.ValueType, value float64, labelValues ...string) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Log.LogError("Failed to collect metric, skipped (desc: %s): %v", desc, err)
+		}
+	}()
+
+	ch <- prometheus.MustNewConstMetric(desc, valueType, value, labelValues...)
 }
@@ -0,0 +1,64 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package metric
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/meloncoffee/weblin/internal/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestMain 테스트 공통 초기화 (로그는 버림)
+func TestMain(m *testing.M) {
+	logger.Log.InitializeLoggerWithWriter(zapcore.AddSync(io.Discard))
+
+	os.Exit(m.Run())
+}
+
+// TestCollectSkipsBadMetric 라벨 개수가 맞지 않는 메트릭이 있어도 나머지 메트릭은 수집되는지 확인
+func TestCollectSkipsBadMetric(t *testing.T) {
+	m := NewMetrics()
+	// 라벨 값 없이 전송되는 메트릭에 라벨을 추가하여 MustNewConstMetric 패닉 유발
+	bad := prometheus.NewDesc("weblin_test_bad_metric", "Metric with mismatched labels", []string{"extra"}, nil)
+	m.MemUsageRate = bad
+
+	ch := make(chan prometheus.Metric, 1024)
+	done := make(chan struct{})
+	got := make(map[*prometheus.Desc]int)
+	go func() {
+		defer close(done)
+		for metric := range ch {
+			got[metric.Desc()]++
+		}
+	}()
+	m.Collect(ch)
+	close(ch)
+	<-done
+
+	if got[bad] != 0 {
+		t.Fatalf("bad metric was emitted %d times, want 0", got[bad])
+	}
+	for _, desc := range []*prometheus.Desc{m.CPUUsageRate, m.OpenFDs, m.MaxFDs} {
+		if got[desc] == 0 {
+			t.Errorf("metric %s was not emitted", desc)
+		}
+	}
+}
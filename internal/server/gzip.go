@@ -0,0 +1,198 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gzip.Writer 재사용 풀
+var gzipWriterPool = sync.Pool{
+	New: func() any {
+		return gzip.NewWriter(io.Discard)
+	},
+}
+
+// gzipResponseWriter 응답 바디를 gzip으로 압축하는 gin.ResponseWriter
+//
+// 응답 크기가 minSize 이상이 될 때까지 버퍼링한 후 압축 여부를 결정
+type gzipResponseWriter struct {
+	gin.ResponseWriter
+	minSize int
+	buf     []byte
+	// 압축 여부 결정 완료 여부
+	decided bool
+	gz      *gzip.Writer
+}
+
+// Write 응답 바디 쓰기
+//
+// Parameters:
+//   - data: 응답 데이터
+//
+// Returns:
+//   - int: 쓴 바이트 수
+//   - error: 성공(nil), 실패(error)
+func (w *gzipResponseWriter) Write(data []byte) (int, error) {
+	if w.decided {
+		if w.gz != nil {
+			return w.gz.Write(data)
+		}
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buf = append(w.buf, data...)
+	if len(w.buf) >= w.minSize {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(data), nil
+}
+
+// WriteString 응답 바디 문자열 쓰기
+//
+// Parameters:
+//   - s: 응답 문자열
+//
+// Returns:
+//   - int: 쓴 바이트 수
+//   - error: 성공(nil), 실패(error)
+func (w *gzipResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush 버퍼링된 응답 데이터 전송
+func (w *gzipResponseWriter) Flush() {
+	if !w.decided {
+		_ = w.decide(len(w.buf) >= w.minSize)
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide 압축 여부를 결정하고 버퍼링된 데이터 전송
+//
+// 핸들러가 직접 Content-Encoding을 설정한 경우(ex: promhttp의 자체 압축) 압축하지 않음
+//
+// Parameters:
+//   - compress: 압축 여부
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func (w *gzipResponseWriter) decide(compress bool) error {
+	w.decided = true
+
+	header := w.Header()
+	if compress && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", "gzip")
+		// 압축 후 크기가 달라지므로 핸들러가 설정한 Content-Length 제거
+		header.Del("Content-Length")
+
+		w.gz = gzipWriterPool.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if w.gz != nil {
+		_, err := w.gz.Write(buf)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+// close 남은 응답 데이터 전송 및 gzip 스트림 종료
+func (w *gzipResponseWriter) close() {
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		gzipWriterPool.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+// gzipMiddleware gzip 응답 압축 미들웨어
+//
+// gzip을 허용(Accept-Encoding)한 요청에 대해 minSize 이상의 응답만 압축
+//
+// Parameters:
+//   - minSize: 압축 최소 응답 크기 (바이트 단위)
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) gzipMiddleware(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 응답이 Accept-Encoding에 따라 달라지므로 캐시에 알림
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		// HEAD 요청 및 프로토콜 업그레이드(WebSocket 등) 요청은 압축하지 않음
+		if c.Request.Method == http.MethodHead || c.GetHeader("Upgrade") != "" ||
+			!acceptsGzip(c.GetHeader("Accept-Encoding")) {
+			c.Next()
+			return
+		}
+
+		w := &gzipResponseWriter{ResponseWriter: c.Writer, minSize: minSize}
+		c.Writer = w
+		defer func() {
+			w.close()
+			c.Writer = w.ResponseWriter
+		}()
+
+		c.Next()
+	}
+}
+
+// acceptsGzip Accept-Encoding 헤더가 gzip을 허용하는지 확인
+//
+// Parameters:
+//   - acceptEncoding: Accept-Encoding 헤더 값 (ex: "gzip, deflate;q=0.5")
+//
+// Returns:
+//   - bool: 허용(true), 비허용(false)
+func acceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		// q=0은 명시적으로 거부한 것
+		q := strings.ReplaceAll(strings.TrimSpace(params), " ", "")
+		if q == "q=0" || q == "q=0.0" || q == "q=0.00" || q == "q=0.000" {
+			return false
+		}
+		return true
+	}
+	return false
+}
@@ -18,9 +18,15 @@ package server
 
 import (
 	"net/http"
+	"net/http/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/meloncoffee/weblin/config"
+	"github.com/meloncoffee/weblin/internal/logger"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -32,12 +38,136 @@ func metricsHandler(c *gin.Context) {
 	promhttp.Handler().ServeHTTP(c.Writer, c.Request)
 }
 
+// 하위 시스템 상태 값
+const (
+	healthOK   = "ok"
+	healthFail = "fail"
+)
+
+// subsystemHealth 하위 시스템 상태 정보 구조체
+type subsystemHealth struct {
+	Status     string          `json:"status"`               // 상태 (ok, fail)
+	Reason     string          `json:"reason,omitempty"`     // 상태 사유
+	LoadedAt   *time.Time      `json:"loadedAt,omitempty"`   // 설정 로드 시각
+	LastSample *time.Time      `json:"lastSample,omitempty"` // 마지막 리소스 샘플링 성공 시각
+	Tasks      map[string]bool `json:"tasks,omitempty"`      // 작업 별 가동 여부
+}
+
 // healthHandler 헬스 체크 핸들러
 //
+// 기본적으로 하위 시스템 확인 없이 빈 200으로 응답하는 liveness 체크이며,
+// 샘플링 지연 등으로 프로세스가 재시작되지 않도록 하위 시스템 상태는 반영하지 않음.
+// verbose=true 쿼리를 지정하면 주요 하위 시스템(로거, 설정, 리소스 샘플러, 고루틴 작업) 상태를 JSON으로 응답하며,
+// 하나라도 비정상이면 비정상 하위 시스템 목록과 함께 503 응답.
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func (s *Server) healthHandler(c *gin.Context) {
+	if verbose, err := strconv.ParseBool(c.DefaultQuery("verbose", "false")); err != nil || !verbose {
+		c.AbortWithStatus(http.StatusOK)
+		return
+	}
+
+	subsystems := s.checkSubsystems()
+	failing := make([]string, 0)
+	for name, health := range subsystems {
+		if health.Status != healthOK {
+			failing = append(failing, name)
+		}
+	}
+	sort.Strings(failing)
+
+	if len(failing) > 0 {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status":     healthFail,
+			"failing":    failing,
+			"subsystems": subsystems,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":     healthOK,
+		"subsystems": subsystems,
+	})
+}
+
+// checkSubsystems 하위 시스템 별 상태 확인
+//
+// Returns:
+//   - map[string]subsystemHealth: 하위 시스템명 별 상태 정보
+func (s *Server) checkSubsystems() map[string]subsystemHealth {
+	subsystems := make(map[string]subsystemHealth, 4)
+
+	// 로거 초기화 여부
+	if logger.Log.Initialized() {
+		subsystems["logger"] = subsystemHealth{Status: healthOK}
+	} else {
+		subsystems["logger"] = subsystemHealth{Status: healthFail, Reason: "not initialized"}
+	}
+
+	// 설정 로드 여부
+	if loadTime := config.LoadTime(); !loadTime.IsZero() {
+		subsystems["config"] = subsystemHealth{Status: healthOK, LoadedAt: &loadTime}
+	} else {
+		subsystems["config"] = subsystemHealth{Status: healthFail, Reason: "not loaded"}
+	}
+
+	// 리소스 샘플링 지연 여부 (샘플링 주기의 3배 이상 성공하지 못하면 비정상)
+	if s.Sampler != nil {
+		health := subsystemHealth{Status: healthOK}
+		if lastSample := s.Sampler.LastSample(); lastSample.IsZero() {
+			health.Reason = "waiting for first sample"
+		} else {
+			health.LastSample = &lastSample
+			staleAfter := 3 * s.Sampler.CurrentInterval()
+			if time.Since(lastSample) > staleAfter {
+				health.Status = healthFail
+				health.Reason = "resource sample is stale"
+			}
+		}
+		subsystems["sampler"] = health
+	}
+
+	// 고루틴 작업 가동 여부
+	if s.Tasks != nil {
+		health := subsystemHealth{Status: healthOK, Tasks: make(map[string]bool)}
+		var stopped []string
+		for _, name := range s.Tasks.ListTasks() {
+			running := s.Tasks.IsRunning(name)
+			health.Tasks[name] = running
+			if !running {
+				stopped = append(stopped, name)
+			}
+		}
+		if len(stopped) > 0 {
+			health.Status = healthFail
+			health.Reason = "stopped tasks: " + strings.Join(stopped, ", ")
+		}
+		subsystems["tasks"] = health
+	}
+
+	return subsystems
+}
+
+// readyHandler 준비 상태 체크 핸들러
+//
+// 초기화 완료 및 첫 리소스 샘플링 성공 전까지 503 응답
+//
 // Parameters:
 //   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
-func healthHandler(c *gin.Context) {
-	c.AbortWithStatus(http.StatusOK)
+func readyHandler(c *gin.Context) {
+	if ok, reason := isReady(); !ok {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "not ready",
+			"reason": reason,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "ready",
+	})
 }
 
 // sysStatsHandler 서버 상태 정보 핸들러
@@ -45,7 +175,109 @@ func healthHandler(c *gin.Context) {
 // Parameters:
 //   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
 func sysStatsHandler(c *gin.Context) {
-	c.JSON(http.StatusOK, servStats.Data())
+	c.JSON(http.StatusOK, currentServStats().Data())
+}
+
+// sysStatsResetHandler 서버 상태 정보 초기화 핸들러
+//
+// 부하 테스트 구간 등을 측정할 수 있도록 누적된 서버 상태 정보를 초기화하며,
+// 초기화 이전 값이 유실되지 않도록 응답으로 반환
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func sysStatsResetHandler(c *gin.Context) {
+	prev := resetServStats()
+	logger.Log.LogInfo("Server stats reset (IP: %s)", c.ClientIP())
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "reset",
+		"stats":  prev.Data(),
+	})
+}
+
+// sysTasksHandler 고루틴 작업 상태 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func (s *Server) sysTasksHandler(c *gin.Context) {
+	type taskStatus struct {
+		Name     string `json:"name"`
+		Running  bool   `json:"running"`
+		Restarts uint64 `json:"restarts"`
+	}
+
+	names := s.Tasks.ListTasks()
+	tasks := make([]taskStatus, 0, len(names))
+	for _, name := range names {
+		tasks = append(tasks, taskStatus{
+			Name:     name,
+			Running:  s.Tasks.IsRunning(name),
+			Restarts: s.Tasks.Restarts(name),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"tasks": tasks,
+	})
+}
+
+// logLevelHandler 현재 로그 기록 레벨 조회 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func logLevelHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"level": logger.Log.Level(),
+	})
+}
+
+// setLogLevelHandler 로그 기록 레벨 변경 핸들러
+//
+// 요청 본문: {"level":"debug"} (debug, info, warn, error)
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func setLogLevelHandler(c *gin.Context) {
+	var req struct {
+		Level string `json:"level" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prev := logger.Log.Level()
+	if err := logger.Log.SetLevel(req.Level); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	logger.Log.LogInfo("Log level changed (%s -> %s)", prev, logger.Log.Level())
+
+	c.JSON(http.StatusOK, gin.H{
+		"level": logger.Log.Level(),
+	})
+}
+
+// pprofHandler net/http/pprof 프로파일링 핸들러
+//
+// /debug/pprof/ 는 프로파일 목록, 그 외 경로는 이름에 해당하는 프로파일 제공
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func pprofHandler(c *gin.Context) {
+	switch c.Param("name") {
+	case "/cmdline":
+		pprof.Cmdline(c.Writer, c.Request)
+	case "/profile":
+		pprof.Profile(c.Writer, c.Request)
+	case "/symbol":
+		pprof.Symbol(c.Writer, c.Request)
+	case "/trace":
+		pprof.Trace(c.Writer, c.Request)
+	default:
+		// pprof.Index가 /debug/pprof/ 접두사 이후 이름으로 goroutine, heap 등의 프로파일 제공
+		pprof.Index(c.Writer, c.Request)
+	}
 }
 
 // versionHandler 버전 정보 핸들러
@@ -59,6 +291,29 @@ func versionHandler(c *gin.Context) {
 	})
 }
 
+// noRouteHandler 등록되지 않은 경로 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func noRouteHandler(c *gin.Context) {
+	c.JSON(http.StatusNotFound, gin.H{
+		"error": "not found",
+		"path":  c.Request.URL.Path,
+	})
+}
+
+// noMethodHandler 경로에 등록되지 않은 메서드 핸들러
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func noMethodHandler(c *gin.Context) {
+	c.JSON(http.StatusMethodNotAllowed, gin.H{
+		"error":  "method not allowed",
+		"path":   c.Request.URL.Path,
+		"method": c.Request.Method,
+	})
+}
+
 // rootHandler 루트 경로 핸들러
 //
 // Parameters:
@@ -0,0 +1,147 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/weblin/config"
+)
+
+// TestHealthHandlerLiveness 기본 헬스 체크는 하위 시스템 상태와 무관하게 빈 200으로 응답하고
+// verbose=true를 지정한 경우에만 하위 시스템 상태를 반영하는지 확인
+func TestHealthHandlerLiveness(t *testing.T) {
+	// 설정이 로드되지 않아 하위 시스템 확인 시 비정상인 상태
+	router := gin.New()
+	router.GET("/health", (&Server{}).healthHandler)
+
+	tests := []struct {
+		target     string
+		wantStatus int
+		// 응답 바디가 비어 있어야 하는지 여부
+		wantEmpty bool
+	}{
+		{"/health", http.StatusOK, true},
+		{"/health?verbose=false", http.StatusOK, true},
+		{"/health?verbose=invalid", http.StatusOK, true},
+		{"/health?verbose=true", http.StatusServiceUnavailable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if empty := w.Body.Len() == 0; empty != tt.wantEmpty {
+				t.Fatalf("empty body = %v, want %v (body: %q)", empty, tt.wantEmpty, w.Body.String())
+			}
+		})
+	}
+}
+
+// serveJSON 요청을 처리하고 JSON 응답 바디를 디코딩
+//
+// Parameters:
+//   - t: 테스트 상태
+//   - router: gin 엔진
+//   - method: 요청 메서드
+//   - target: 요청 경로
+//   - v: 응답 바디를 디코딩할 값
+func serveJSON(t *testing.T, router http.Handler, method, target string, v any) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("%s %s status = %d, want %d", method, target, w.Code, http.StatusOK)
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("%s %s returned invalid JSON: %v", method, target, err)
+	}
+}
+
+// TestSysStatsReset 초기화 요청 시 이전 통계를 응답하고 이후 통계가 0부터 다시 누적되는지 확인
+func TestSysStatsReset(t *testing.T) {
+	router := (&Server{}).newGinRouterEngine()
+	resetServStats()
+
+	// 초기화 이전 트래픽 기록
+	const requests = 3
+	for i := 0; i < requests; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+
+	var reset struct {
+		Status string `json:"status"`
+		Stats  struct {
+			TotalCount int `json:"total_count"`
+		} `json:"stats"`
+	}
+	serveJSON(t, router, http.MethodPost, config.Conf.API.SysStatResetURI, &reset)
+	if reset.Status != "reset" {
+		t.Fatalf("reset status = %q, want %q", reset.Status, "reset")
+	}
+	if reset.Stats.TotalCount != requests {
+		t.Fatalf("pre-reset total_count = %d, want %d", reset.Stats.TotalCount, requests)
+	}
+
+	// 초기화 요청은 이전 통계에 기록되므로 새 통계는 0부터 시작
+	// (조회 요청은 응답 이후에 기록됨)
+	for want := 0; want < 2; want++ {
+		var current struct {
+			TotalCount int `json:"total_count"`
+		}
+		serveJSON(t, router, http.MethodGet, config.Conf.API.SysStatURI, &current)
+		if current.TotalCount != want {
+			t.Fatalf("post-reset total_count = %d, want %d", current.TotalCount, want)
+		}
+	}
+}
+
+// TestSysStatsResetConcurrent 요청 처리 중 통계 초기화가 반복되어도 경합이 없는지 확인
+// (go test -race로 실행)
+func TestSysStatsResetConcurrent(t *testing.T) {
+	router := (&Server{}).newGinRouterEngine()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, config.Conf.API.SysStatResetURI, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("reset status = %d, want %d", w.Code, http.StatusOK)
+		}
+	}
+	wg.Wait()
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/weblin/internal/logger"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestMain 테스트 공통 초기화 (로그는 버림, 로그 내용을 확인하는 테스트는 로거를 다시 초기화)
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	logger.Log.InitializeLoggerWithWriter(zapcore.AddSync(io.Discard))
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/weblin/config"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 요청 ID 헤더
+const requestIDHeader = "X-Request-ID"
+
+// 외부에서 전달된 요청 ID 최대 길이
+const maxRequestIDLen = 128
+
+var (
+	// CORS 허용 메서드 기본값
+	defaultCORSMethods = []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut}
+	// CORS 허용 헤더 기본값
+	defaultCORSHeaders = []string{"Authorization", "Content-Type"}
+)
+
+// corsMiddleware CORS 미들웨어
+//
+// 허용된 Origin 요청에만 CORS 헤더를 설정하고, preflight 요청은 204로 응답.
+// 자격 증명 허용 시에는 "*" 대신 요청 Origin을 그대로 응답.
+//
+// Parameters:
+//   - conf: CORS 설정
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) corsMiddleware(conf config.CORSYaml) gin.HandlerFunc {
+	allowAll := slices.Contains(conf.AllowOrigins, "*")
+	origins := make(map[string]struct{}, len(conf.AllowOrigins))
+	for _, origin := range conf.AllowOrigins {
+		origins[origin] = struct{}{}
+	}
+
+	methods := conf.AllowMethods
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+	allowMethods := strings.Join(methods, ", ")
+
+	headers := conf.AllowHeaders
+	if len(headers) == 0 {
+		headers = defaultCORSHeaders
+	}
+	allowHeaders := strings.Join(headers, ", ")
+	maxAge := strconv.Itoa(conf.MaxAgeSec)
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		// 동일 출처 요청 또는 브라우저 외 클라이언트 요청
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		preflight := c.Request.Method == http.MethodOptions &&
+			c.GetHeader("Access-Control-Request-Method") != ""
+
+		// 응답이 Origin에 따라 달라지므로 캐시에 알림
+		c.Writer.Header().Add("Vary", "Origin")
+
+		_, allowed := origins[origin]
+		if !allowed && !allowAll {
+			if preflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if allowAll && !conf.AllowCredentials {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+		}
+		if conf.AllowCredentials {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		if preflight {
+			c.Header("Access-Control-Allow-Methods", allowMethods)
+			c.Header("Access-Control-Allow-Headers", allowHeaders)
+			c.Header("Access-Control-Max-Age", maxAge)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// basicAuthMiddleware HTTP 기본 인증 미들웨어
+//
+// 보호 경로 요청의 자격 증명을 검사하고, 실패 시 WWW-Authenticate 헤더와 함께 401 응답.
+// 헬스/준비 상태 체크 경로는 로드 밸런서가 차단되지 않도록 항상 인증에서 제외.
+//
+// Parameters:
+//   - conf: 기본 인증 설정
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) basicAuthMiddleware(conf config.BasicAuthYaml) gin.HandlerFunc {
+	paths := make(map[string]struct{}, len(conf.Paths))
+	for _, path := range conf.Paths {
+		paths[path] = struct{}{}
+	}
+	username := []byte(conf.Username)
+	passwordHash := []byte(conf.PasswordHash)
+
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		if s.isProbePath(path) {
+			c.Next()
+			return
+		}
+		// 보호 경로가 지정된 경우 해당 경로만 인증
+		if len(paths) > 0 {
+			if _, ok := paths[path]; !ok {
+				c.Next()
+				return
+			}
+		}
+
+		user, password, ok := c.Request.BasicAuth()
+		if ok {
+			userMatch := subtle.ConstantTimeCompare([]byte(user), username) == 1
+			// 사용자명이 틀려도 비밀번호 검사를 수행하여 응답 시간으로 사용자명을 추측할 수 없게 함
+			passwordMatch := bcrypt.CompareHashAndPassword(passwordHash, []byte(password)) == nil
+			if userMatch && passwordMatch {
+				c.Next()
+				return
+			}
+		}
+
+		c.Header("WWW-Authenticate", `Basic realm="`+config.ModuleName+`", charset="UTF-8"`)
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+}
+
+// requestIDMiddleware 요청 ID 미들웨어
+//
+// X-Request-ID 요청 헤더 값을 사용하고, 없거나 형식이 잘못되었으면 UUID를 생성.
+// 요청 ID는 gin 컨텍스트에 저장되고 응답 헤더로 반환됨.
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) requestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(requestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = newRequestID()
+		}
+
+		c.Set(requestIDKey, requestID)
+		c.Header(requestIDHeader, requestID)
+		c.Next()
+	}
+}
+
+// isValidRequestID 외부에서 전달된 요청 ID 유효성 검사
+//
+// 로그 위조를 막기 위해 출력 가능한 ASCII 문자만 허용
+//
+// Parameters:
+//   - id: 요청 ID
+//
+// Returns:
+//   - bool: 유효(true), 무효(false)
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID crypto/rand 기반 UUID(v4) 요청 ID 생성
+//
+// Returns:
+//   - string: 요청 ID
+func newRequestID() string {
+	var b [16]byte
+	// Linux의 crypto/rand.Read(getrandom)는 사실상 실패하지 않으므로 에러를 확인하지 않음
+	_, _ = rand.Read(b[:])
+	// 버전(4) 및 variant(RFC 4122) 비트 설정
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
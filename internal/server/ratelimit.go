@@ -0,0 +1,151 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/weblin/internal/logger"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// 마지막 요청 이후 클라이언트 limiter를 유지하는 시간
+	rateLimitIdleTTL = 3 * time.Minute
+	// 유휴 클라이언트 limiter 정리 주기
+	rateLimitSweepInterval = time.Minute
+)
+
+// clientLimiter 클라이언트별 token bucket 정보 구조체
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+	// 요청 제한 상태 (제한 시작 시 한번만 로깅하기 위해 사용)
+	limited bool
+}
+
+// ipRateLimiter 클라이언트 IP별 요청 수 제한 구조체
+type ipRateLimiter struct {
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	limit     rate.Limit
+	burst     int
+	lastSweep time.Time
+}
+
+// newIPRateLimiter ipRateLimiter 생성자
+//
+// Parameters:
+//   - perSec: 클라이언트별 초당 허용 요청 수
+//   - burst: 클라이언트별 순간 최대 허용 요청 수
+//
+// Returns:
+//   - *ipRateLimiter: ipRateLimiter 구조체 포인터
+func newIPRateLimiter(perSec float64, burst int) *ipRateLimiter {
+	return &ipRateLimiter{
+		clients:   make(map[string]*clientLimiter),
+		limit:     rate.Limit(perSec),
+		burst:     burst,
+		lastSweep: time.Now(),
+	}
+}
+
+// reserve 클라이언트 요청 허용 여부 확인
+//
+// Parameters:
+//   - ip: 클라이언트 IP
+//
+// Returns:
+//   - time.Duration: 다음 요청이 허용될 때까지 대기 시간 (0이면 허용)
+//   - bool: 요청 제한이 새로 시작되었는지 여부
+func (l *ipRateLimiter) reserve(ip string) (time.Duration, bool) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 오래 요청이 없었던 클라이언트 정리 (별도 고루틴 없이 요청 처리 중에 수행)
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		for key, client := range l.clients {
+			if now.Sub(client.lastSeen) >= rateLimitIdleTTL {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	client, ok := l.clients[ip]
+	if !ok {
+		client = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = client
+	}
+	client.lastSeen = now
+
+	r := client.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		// 거부한 요청은 토큰을 소비하지 않도록 예약 취소
+		r.CancelAt(now)
+		started := !client.limited
+		client.limited = true
+		return delay, started
+	}
+	client.limited = false
+
+	return 0, false
+}
+
+// rateLimitMiddleware 클라이언트 IP별 요청 수 제한 미들웨어 (token bucket)
+//
+// 제한을 초과한 요청은 Retry-After 헤더와 함께 429로 응답하며, 헬스/준비 상태 체크 경로는 제한에서 제외.
+// 클라이언트 IP는 c.ClientIP()로 획득하므로 신뢰 프록시 설정을 따름.
+//
+// Parameters:
+//   - perSec: 클라이언트별 초당 허용 요청 수
+//   - burst: 클라이언트별 순간 최대 허용 요청 수
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) rateLimitMiddleware(perSec float64, burst int) gin.HandlerFunc {
+	limiter := newIPRateLimiter(perSec, burst)
+
+	return func(c *gin.Context) {
+		if s.isProbePath(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		clientIP := c.ClientIP()
+		delay, started := limiter.reserve(clientIP)
+		if delay == 0 {
+			c.Next()
+			return
+		}
+
+		// 제한된 요청마다 로깅하지 않고 제한이 시작될 때만 로깅
+		if started {
+			logger.Log.LogWarn("Rate limit exceeded, rejecting requests (IP: %s)", clientIP)
+		}
+
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		c.AbortWithStatus(http.StatusTooManyRequests)
+	}
+}
@@ -22,27 +22,84 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"os"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/meloncoffee/weblin/config"
 	"github.com/meloncoffee/weblin/internal/logger"
+	"github.com/meloncoffee/weblin/internal/metric"
 	"github.com/meloncoffee/weblin/pkg/utils/file"
+	"github.com/meloncoffee/weblin/pkg/utils/goroutine"
 	"github.com/meloncoffee/weblin/pkg/utils/process"
+	"github.com/meloncoffee/weblin/pkg/utils/resource"
+	"github.com/meloncoffee/weblin/pkg/utils/systemd"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/thoas/stats"
 )
 
 var (
 	doOnce sync.Once
-	// 서버 응답 시간 및 상태 코드 카운트
+	// 서버 응답 시간 및 상태 코드 카운트 (초기화 요청 시 교체되므로 statsMu로 보호)
 	servStats *stats.Stats
+	statsMu   sync.RWMutex
+	// HTTP 요청 Prometheus 메트릭
+	httpMetrics *metric.HTTPMetrics
+	// 처리 중인 요청 수
+	inFlight atomic.Int64
+	// 서버 리스닝 여부
+	listening atomic.Bool
+	// 모듈 초기화 완료 여부
+	initialized atomic.Bool
+	// 서버 종료 진행 여부
+	draining atomic.Bool
+	// 초기화 이후 추가 준비 상태 확인 함수 (initialized 설정 전에만 변경)
+	readyCheck func() bool
+	// 설정 재로드로 변경된 접근 로그 설정 (nil이면 설정 파일 값 사용)
+	accessLogOpts atomic.Pointer[AccessLogOptions]
 )
 
-type Server struct{}
+// AccessLogOptions 재시작 없이 변경 가능한 접근 로그 설정 구조체
+type AccessLogOptions struct {
+	Structured    bool          // 구조화된 필드로 기록할지 여부
+	SlowThreshold time.Duration // 느린 요청 기준 시간 (0이면 비활성화)
+}
+
+const (
+	// 이전 인스턴스가 포트를 해제할 때까지 대기하는 최대 시간
+	bindSettleTimeout = 5 * time.Second
+	// 포트 바인딩 재시도 간격
+	bindRetryInterval = 200 * time.Millisecond
+)
+
+// gin 컨텍스트에 저장되는 핸들러 시작/종료 시간 및 요청 ID 키
+const (
+	handlerStartKey = "weblin.handlerStart"
+	handlerEndKey   = "weblin.handlerEnd"
+	requestIDKey    = "weblin.requestID"
+)
+
+// 프로파일링 엔드포인트 경로
+const pprofURI = "/debug/pprof"
+
+type Server struct {
+	// 리소스 사용률 WebSocket 전송 구조체 (nil이면 WebSocket 엔드포인트 비활성화)
+	StatsHub *StatsHub
+	// 고루틴 작업 관리 구조체 (nil이면 작업 상태 엔드포인트 비활성화)
+	Tasks *goroutine.GoroutineManager
+	// 백그라운드 리소스 사용률 샘플러 (nil이면 헬스 체크에서 샘플러 상태 생략)
+	Sampler *resource.Sampler
+}
 
 // Run 메인 서버 가동
 //
@@ -53,6 +110,14 @@ func (s *Server) Run(ctx context.Context) {
 	var err error
 	isTLS := false
 	port := config.Conf.Server.Port
+	addr := net.JoinHostPort(config.Conf.Server.BindAddr, strconv.Itoa(port))
+
+	// 리스닝 주소 유효성 검사
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		logger.Log.LogError("Invalid listen address (%s): %v", addr, err)
+		process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+		return
+	}
 
 	if config.Conf.Server.TLS.Enabled {
 		// TLS 인증서 및 키 파일 유효성 검사
@@ -84,27 +149,59 @@ func (s *Server) Run(ctx context.Context) {
 			return
 		}
 
+		// 클라이언트 인증서 검증 설정 (mTLS)
+		clientCAPath := config.Conf.Server.TLS.ClientCAPath
+		if clientCAPath != "" {
+			if !file.IsFileExists(clientCAPath) {
+				logger.Log.LogError("Not found TLS client CA (CA path: %s)", clientCAPath)
+				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+				return
+			}
+			tlsConf.ClientCAs, err = s.loadCertPool(clientCAPath)
+			if err != nil {
+				logger.Log.LogError("Failed to load TLS client CA: %v", err)
+				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+				return
+			}
+
+			if config.Conf.Server.TLS.RequireClientCert {
+				tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+			} else {
+				tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+		}
+
 		isTLS = true
 	}
 
 	// HTTP 서버 설정
 	server := &http.Server{
-		Addr: ":" + strconv.Itoa(port),
+		Addr: addr,
 		// gin 엔진 설정
 		Handler: s.newGinRouterEngine(),
-		// 요청 타임아웃 10초 설정
-		ReadTimeout: 10 * time.Second,
-		// 응답 타임아웃 10초 설정
-		WriteTimeout: 10 * time.Second,
+		// 요청 타임아웃 설정
+		ReadTimeout: time.Duration(config.Conf.Server.ReadTimeoutSec) * time.Second,
+		// 응답 타임아웃 설정
+		WriteTimeout: time.Duration(config.Conf.Server.WriteTimeoutSec) * time.Second,
+		// 유휴 연결 타임아웃 설정 (0이면 ReadTimeout 사용)
+		IdleTimeout: time.Duration(config.Conf.Server.IdleTimeoutSec) * time.Second,
 		// 요청 헤더 최대 크기를 1MB로 설정
 		MaxHeaderBytes: 1 << 20,
 	}
 
+	// 리스너 생성 (이전 인스턴스가 포트를 해제할 때까지 대기)
+	ln, err := s.listen(ctx, server.Addr)
+	if err != nil {
+		logger.Log.LogError("Failed to listen on %s: %v", addr, err)
+		process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
+		return
+	}
+
 	// HTTP 서버 가동
 	if isTLS {
 		server.TLSConfig = &tlsConf
 		go func() {
-			err := server.ListenAndServeTLS("", "")
+			err := server.ServeTLS(ln, "", "")
 			if err != nil && err != http.ErrServerClosed {
 				logger.Log.LogError("Server error occurred: %v", err)
 				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
@@ -112,7 +209,7 @@ func (s *Server) Run(ctx context.Context) {
 		}()
 	} else {
 		go func() {
-			err := server.ListenAndServe()
+			err := server.Serve(ln)
 			if err != nil && err != http.ErrServerClosed {
 				logger.Log.LogError("Server error occurred: %v", err)
 				process.SendSignal(config.RunConf.Pid, syscall.SIGUSR1)
@@ -120,23 +217,147 @@ func (s *Server) Run(ctx context.Context) {
 		}()
 	}
 
-	logger.Log.LogInfo("Server listening on port %d", port)
+	listening.Store(true)
+	logger.Log.LogInfo("Server listening on %s", addr)
+
+	// systemd에 서비스 시작 완료 알림 (systemd 관리 하에 있지 않으면 무시됨)
+	if _, err := systemd.Notify(systemd.NotifyReady); err != nil {
+		logger.Log.LogWarn("Failed to notify systemd: %v", err)
+	}
 
 	// 서버 종료 신호 대기
 	<-ctx.Done()
 
-	// 종료 신호를 받았으면 graceful shutdown을 위해 5초 타임아웃 설정
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// 로드 밸런서가 트래픽을 뺄 수 있도록 준비 상태를 먼저 해제하고 대기
+	draining.Store(true)
+	if delay := time.Duration(config.Conf.Server.PreShutdownDelaySec) * time.Second; delay > 0 {
+		logger.Log.LogInfo("Server marked not ready, waiting %v before shutdown", delay)
+		time.Sleep(delay)
+	}
+	listening.Store(false)
+
+	// 종료 신호를 받았으면 graceful shutdown을 위해 타임아웃 설정
+	shutdownTimeout := time.Duration(config.Conf.Server.ShutdownTimeoutSec) * time.Second
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 
 	// 서버 종료
 	err = server.Shutdown(shutdownCtx)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			logger.Log.LogWarn("Server shutdown timed out after %v (in-flight requests: %d)",
+				shutdownTimeout, inFlight.Load())
+			return
+		}
 		logger.Log.LogWarn("Server shutdown: %v", err)
 		return
 	}
 
-	logger.Log.LogInfo("Server shutdown on port %d", port)
+	logger.Log.LogInfo("Server shutdown on %s", addr)
+}
+
+// SetReady 모듈 초기화 완료 설정
+//
+// 초기화 완료 후 서버가 리스닝 중이고 check가 true를 반환하면 준비 상태로 응답
+//
+// Parameters:
+//   - check: 추가 준비 상태 확인 함수 (nil이면 확인하지 않음)
+func SetReady(check func() bool) {
+	readyCheck = check
+	initialized.Store(true)
+}
+
+// isReady 서버 준비 상태 확인
+//
+// Returns:
+//   - bool: 준비 완료(true), 준비 중(false)
+//   - string: 준비 중인 이유
+func isReady() (bool, string) {
+	if draining.Load() {
+		return false, "shutting down"
+	}
+	if !initialized.Load() {
+		return false, "initializing"
+	}
+	if !listening.Load() {
+		return false, "server not listening"
+	}
+	if readyCheck != nil && !readyCheck() {
+		return false, "waiting for first resource sample"
+	}
+	return true, ""
+}
+
+// loadCertPool PEM 형식의 CA 인증서 파일로 인증서 풀 생성
+//
+// Parameters:
+//   - caPath: CA 인증서 파일 경로
+//
+// Returns:
+//   - *x509.CertPool: 인증서 풀
+//   - error: 성공(nil), 실패(error)
+func (s *Server) loadCertPool(caPath string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no PEM certificate found in %s", caPath)
+	}
+
+	return pool, nil
+}
+
+// isProbePath 헬스/준비 상태 체크 경로인지 확인
+//
+// Parameters:
+//   - path: 요청 경로
+//
+// Returns:
+//   - bool: 체크 경로(true), 그 외(false)
+func (s *Server) isProbePath(path string) bool {
+	return path == config.Conf.API.HealthURI || path == config.Conf.API.ReadyURI
+}
+
+// listen TCP 리스너 생성
+//
+// 빠른 stop/start 반복 시 이전 인스턴스가 아직 포트를 점유하고 있을 수 있으므로
+// EADDRINUSE 에러가 발생하면 bindSettleTimeout 동안 재시도
+//
+// Parameters:
+//   - ctx: 서버 종료 컨텍스트
+//   - addr: 리스닝 주소
+//
+// Returns:
+//   - net.Listener: TCP 리스너
+//   - error: 성공(nil), 실패(error)
+func (s *Server) listen(ctx context.Context, addr string) (net.Listener, error) {
+	deadline := time.Now().Add(bindSettleTimeout)
+
+	for {
+		ln, err := net.Listen("tcp", addr)
+		if err == nil {
+			return ln, nil
+		}
+		if !errors.Is(err, syscall.EADDRINUSE) {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("address still in use after %.0fsec "+
+				"(previous instance may not have released it): %v",
+				bindSettleTimeout.Seconds(), err)
+		}
+
+		logger.Log.LogDebug("Address in use, retrying bind (addr: %s)", addr)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(bindRetryInterval):
+		}
+	}
 }
 
 // newRouterEngine gin 엔진 생성
@@ -147,7 +368,12 @@ func (s *Server) newGinRouterEngine() *gin.Engine {
 	// 런타임 중 한번만 호출됨
 	doOnce.Do(func() {
 		// Stats 구조체 생성
-		servStats = stats.New()
+		resetServStats()
+		httpMetrics = metric.NewHTTPMetrics()
+		// 메트릭 수집기 등록 (작업 상태 메트릭은 고루틴 관리 구조체가 설정된 경우에만 수집)
+		metrics := metric.NewMetrics()
+		metrics.Tasks = s.Tasks
+		prometheus.MustRegister(metrics, httpMetrics)
 	})
 
 	// gin 동작 모드 설정
@@ -160,35 +386,111 @@ func (s *Server) newGinRouterEngine() *gin.Engine {
 
 	// gin 라우터 생성
 	r := gin.New()
+	// 경로는 있지만 메서드가 다른 요청은 404 대신 405로 응답
+	r.HandleMethodNotAllowed = true
+
+	// 신뢰 프록시 설정 (설정하지 않으면 gin 기본 동작 유지)
+	if len(config.Conf.API.TrustedProxies) > 0 {
+		if err := r.SetTrustedProxies(config.Conf.API.TrustedProxies); err != nil {
+			logger.Log.LogError("Failed to set trusted proxies: %v", err)
+		}
+	}
 
 	// 복구 미들웨어 등록
 	r.Use(gin.Recovery())
+	// 요청 ID 미들웨어 등록
+	r.Use(s.requestIDMiddleware())
+	// 요청 수 제한 미들웨어 등록 (제한된 요청이 로그를 채우지 않도록 로깅 미들웨어보다 먼저 등록)
+	if config.Conf.API.RateLimitPerSec > 0 {
+		r.Use(s.rateLimitMiddleware(config.Conf.API.RateLimitPerSec, config.Conf.API.RateLimitBurst))
+	}
 	// 요청/응답 정보 로깅 미들웨어 등록
 	r.Use(s.ginLoggerMiddleware())
 	// 버전 정보 미들웨어 등록
 	r.Use(s.versionMiddleware())
+	// CORS 미들웨어 등록 (허용 Origin이 설정된 경우에만)
+	if len(config.Conf.API.CORS.AllowOrigins) > 0 {
+		r.Use(s.corsMiddleware(config.Conf.API.CORS))
+	}
+	// 기본 인증 미들웨어 등록 (사용자명이 설정된 경우에만)
+	if config.Conf.API.BasicAuth.Username != "" {
+		r.Use(s.basicAuthMiddleware(config.Conf.API.BasicAuth))
+	}
+	// gzip 응답 압축 미들웨어 등록
+	if config.Conf.API.GzipEnabled {
+		r.Use(s.gzipMiddleware(config.Conf.API.GzipMinSize))
+	}
 	// 요청 통계를 수집하고 기록하는 미들웨어 등록
 	r.Use(s.statMiddleware())
+	// 핸들러 처리 시간 측정 미들웨어 등록 (디버그 모드에서만 동작)
+	if config.RunConf.DebugMode {
+		r.Use(s.timingMiddleware())
+	}
 
 	// 요청 핸들러 등록
 	r.GET(config.Conf.API.MetricURI, metricsHandler)
-	r.GET(config.Conf.API.HealthURI, healthHandler)
+	r.GET(config.Conf.API.HealthURI, s.healthHandler)
+	r.GET(config.Conf.API.ReadyURI, readyHandler)
 	r.GET(config.Conf.API.SysStatURI, sysStatsHandler)
+	r.POST(config.Conf.API.SysStatResetURI, sysStatsResetHandler)
 	r.GET("/version", versionHandler)
+	r.GET("/log/level", logLevelHandler)
+	r.PUT("/log/level", setLogLevelHandler)
 	r.GET("/", rootHandler)
+	// 고루틴 작업 상태 핸들러 등록
+	if s.Tasks != nil {
+		r.GET("/sys/tasks", s.sysTasksHandler)
+	}
+	// 리소스 사용률 WebSocket 핸들러 등록
+	if s.StatsHub != nil {
+		r.GET(config.Conf.API.WSStatsURI, s.StatsHub.wsStatsHandler)
+	}
+	// 등록되지 않은 경로/메서드 핸들러 등록 (전역 미들웨어를 거친 후 호출됨)
+	r.NoRoute(noRouteHandler)
+	r.NoMethod(noMethodHandler)
+	// 프로파일링 핸들러 등록 (디버그 모드 또는 설정으로 활성화한 경우에만)
+	if config.RunConf.DebugMode || config.Conf.API.PprofEnabled {
+		r.Any(pprofURI+"/*name", pprofHandler)
+	}
 
 	return r
 }
 
+// SetAccessLogOptions 접근 로그 설정 변경 (처리 중인 요청과 경합하지 않도록 통째로 교체)
+//
+// Parameters:
+//   - opts: 접근 로그 설정
+func SetAccessLogOptions(opts AccessLogOptions) {
+	accessLogOpts.Store(&opts)
+}
+
+// currentAccessLogOptions 현재 적용 중인 접근 로그 설정 반환
+//
+// Returns:
+//   - AccessLogOptions: 변경된 설정이 없으면 설정 파일 값
+func currentAccessLogOptions() AccessLogOptions {
+	if opts := accessLogOpts.Load(); opts != nil {
+		return *opts
+	}
+	return AccessLogOptions{
+		Structured:    config.Conf.Log.StructuredAccessLog,
+		SlowThreshold: time.Duration(config.Conf.Log.SlowRequestThresholdMs) * time.Millisecond,
+	}
+}
+
 // ginLoggerMiddleware gin 요청/응답 정보 로깅 미들웨어
 //
 // Returns:
 //   - gin.HandlerFunc: gin 미들웨어
 func (s *Server) ginLoggerMiddleware() gin.HandlerFunc {
-	// 로깅에서 제외할 경로 설정
+	// 로깅에서 제외할 경로 설정 (기본 제외 경로와 설정 파일의 추가 제외 경로 병합)
 	excludePath := map[string]struct{}{
 		config.Conf.API.MetricURI: {},
 		config.Conf.API.HealthURI: {},
+		config.Conf.API.ReadyURI:  {},
+	}
+	for _, path := range config.Conf.API.AccessLogExcludePaths {
+		excludePath[path] = struct{}{}
 	}
 
 	return func(c *gin.Context) {
@@ -204,14 +506,25 @@ func (s *Server) ginLoggerMiddleware() gin.HandlerFunc {
 		// 요청 처리
 		c.Next()
 
-		// 제외할 경로는 로깅하지 않음
-		if _, ok := excludePath[path]; ok {
+		// 제외할 경로는 로깅하지 않음 (쿼리 문자열을 제외한 경로로 비교)
+		if _, ok := excludePath[c.Request.URL.Path]; ok {
 			return
 		}
 
 		// 요청 종료 시간 및 latency 계산
 		end := time.Now()
 		latency := end.Sub(start)
+		latencyInfo := latency.String()
+
+		// 핸들러 처리 시간이 기록되어 있으면 핸들러/미들웨어 시간을 분리하여 출력
+		if handlerTime, ok := s.handlerLatency(c); ok {
+			latencyInfo = fmt.Sprintf("%v (handler: %v, middleware: %v)",
+				latency, handlerTime, latency-handlerTime)
+		}
+
+		// 느린 요청 여부 확인
+		opts := currentAccessLogOptions()
+		slow := opts.SlowThreshold > 0 && latency > opts.SlowThreshold
 
 		// 로그 메시지 설정
 		var logMsg string
@@ -220,6 +533,9 @@ func (s *Server) ginLoggerMiddleware() gin.HandlerFunc {
 		} else {
 			logMsg = "Request"
 		}
+		if slow {
+			logMsg = "SLOW " + logMsg
+		}
 		// 상태 코드 획득
 		statusCode := c.Writer.Status()
 		// 요청 메서드 획득
@@ -230,17 +546,48 @@ func (s *Server) ginLoggerMiddleware() gin.HandlerFunc {
 		userAgent := c.Request.UserAgent()
 		// 응답 바디 사이즈 획득
 		resBodySize := c.Writer.Size()
+		// 요청 ID 획득
+		requestID := c.GetString(requestIDKey)
+
+		// 구조화된 필드로 로그 출력 (설정으로 활성화한 경우)
+		if opts.Structured {
+			fields := []interface{}{
+				"method", method,
+				"path", path,
+				"status", statusCode,
+				"latency_ms", float64(latency.Microseconds()) / 1000,
+				"client_ip", clientIP,
+				"ua", userAgent,
+				"resp_size", resBodySize,
+				"request_id", requestID,
+			}
+			if handlerTime, ok := s.handlerLatency(c); ok {
+				fields = append(fields, "handler_ms", float64(handlerTime.Microseconds())/1000)
+			}
+			if slow {
+				fields = append(fields, "slow", true)
+			}
+
+			if statusCode >= 500 {
+				logger.Log.LogErrorw(logMsg, fields...)
+			} else if statusCode >= 400 || slow {
+				logger.Log.LogWarnw(logMsg, fields...)
+			} else {
+				logger.Log.LogInfow(logMsg, fields...)
+			}
+			return
+		}
 
-		// 로그 출력 (상태 코드에 따른 로그 레벨 설정)
+		// 로그 출력 (상태 코드에 따른 로그 레벨 설정, 느린 요청은 최소 WARN)
 		if statusCode >= 500 {
-			logger.Log.LogError("[%d] %s %s (IP: %s, Latency: %v, UA: %s, ResSize: %d) %s",
-				statusCode, method, path, clientIP, latency, userAgent, resBodySize, logMsg)
-		} else if statusCode >= 400 {
-			logger.Log.LogWarn("[%d] %s %s (IP: %s, Latency: %v, UA: %s, ResSize: %d) %s",
-				statusCode, method, path, clientIP, latency, userAgent, resBodySize, logMsg)
+			logger.Log.LogError("[%d] %s %s (IP: %s, Latency: %s, UA: %s, ResSize: %d, ReqID: %s) %s",
+				statusCode, method, path, clientIP, latencyInfo, userAgent, resBodySize, requestID, logMsg)
+		} else if statusCode >= 400 || slow {
+			logger.Log.LogWarn("[%d] %s %s (IP: %s, Latency: %s, UA: %s, ResSize: %d, ReqID: %s) %s",
+				statusCode, method, path, clientIP, latencyInfo, userAgent, resBodySize, requestID, logMsg)
 		} else {
-			logger.Log.LogInfo("[%d] %s %s (IP: %s, Latency: %v, UA: %s, ResSize: %d) %s",
-				statusCode, method, path, clientIP, latency, userAgent, resBodySize, logMsg)
+			logger.Log.LogInfo("[%d] %s %s (IP: %s, Latency: %s, UA: %s, ResSize: %d, ReqID: %s) %s",
+				statusCode, method, path, clientIP, latencyInfo, userAgent, resBodySize, requestID, logMsg)
 		}
 	}
 }
@@ -258,12 +605,96 @@ func (s *Server) versionMiddleware() gin.HandlerFunc {
 
 // statMiddleware 요청 통계를 수집하고 기록하는 미들웨어
 //
+// /sys/stats 응답용 통계와 Prometheus HTTP 요청 메트릭을 함께 기록 (프로파일링 요청 제외)
+//
 // Returns:
 //   - gin.HandlerFunc: gin 미들웨어
 func (s *Server) statMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		beginning, recorder := servStats.Begin(c.Writer)
+		inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		// 프로파일링 요청은 서버 통계에서 제외
+		if strings.HasPrefix(c.Request.URL.Path, pprofURI+"/") {
+			c.Next()
+			return
+		}
+
+		// Begin이 반환하는 recorder는 gin 응답 작성에 사용되지 않으므로 gin의 상태 코드/크기를 직접 전달
+		// 처리 중 통계가 초기화되어도 같은 Stats 구조체에 시작/종료를 기록
+		st := currentServStats()
+		beginning, _ := st.Begin(c.Writer)
+		c.Next()
+		st.End(beginning, stats.WithStatusCode(c.Writer.Status()),
+			stats.WithSize(max(c.Writer.Size(), 0)))
+		httpMetrics.Observe(c.Writer.Status(), time.Since(beginning))
+	}
+}
+
+// timingMiddleware 핸들러 처리 시작/종료 시간을 gin 컨텍스트에 기록하는 미들웨어
+//
+// 핸들러 직전에 실행되도록 가장 마지막에 등록되어야 함
+//
+// Returns:
+//   - gin.HandlerFunc: gin 미들웨어
+func (s *Server) timingMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(handlerStartKey, time.Now())
 		c.Next()
-		servStats.End(beginning, stats.WithRecorder(recorder))
+		c.Set(handlerEndKey, time.Now())
 	}
 }
+
+// handlerLatency gin 컨텍스트에 기록된 핸들러 처리 시간 계산
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+//
+// Returns:
+//   - time.Duration: 핸들러 처리 시간
+//   - bool: 기록 존재(true), 미존재(false)
+func (s *Server) handlerLatency(c *gin.Context) (time.Duration, bool) {
+	startVal, ok := c.Get(handlerStartKey)
+	if !ok {
+		return 0, false
+	}
+	endVal, ok := c.Get(handlerEndKey)
+	if !ok {
+		return 0, false
+	}
+
+	handlerStart, ok := startVal.(time.Time)
+	if !ok {
+		return 0, false
+	}
+	handlerEnd, ok := endVal.(time.Time)
+	if !ok {
+		return 0, false
+	}
+
+	return handlerEnd.Sub(handlerStart), true
+}
+
+// currentServStats 현재 서버 통계 구조체 반환
+//
+// Returns:
+//   - *stats.Stats: 서버 통계 구조체
+func currentServStats() *stats.Stats {
+	statsMu.RLock()
+	defer statsMu.RUnlock()
+
+	return servStats
+}
+
+// resetServStats 서버 통계를 새 구조체로 교체하여 누적 값 초기화
+//
+// Returns:
+//   - *stats.Stats: 교체 이전 서버 통계 구조체 (최초 생성 시 nil)
+func resetServStats() *stats.Stats {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+
+	prev := servStats
+	servStats = stats.New()
+	return prev
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/meloncoffee/weblin/config"
+	"github.com/meloncoffee/weblin/internal/logger"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestGinLoggerMiddlewareExcludePaths 기본 제외 경로와 설정한 제외 경로는 로깅하지 않고
+// 그 외 경로는 쿼리 문자열을 포함하여 로깅하는지 확인
+func TestGinLoggerMiddlewareExcludePaths(t *testing.T) {
+	var buf bytes.Buffer
+	logger.Log.InitializeLoggerWithWriter(zapcore.AddSync(&buf))
+	t.Cleanup(func() { logger.Log.InitializeLoggerWithWriter(zapcore.AddSync(io.Discard)) })
+
+	oldExclude := config.Conf.API.AccessLogExcludePaths
+	config.Conf.API.AccessLogExcludePaths = []string{"/internal"}
+	t.Cleanup(func() { config.Conf.API.AccessLogExcludePaths = oldExclude })
+
+	router := gin.New()
+	router.Use((&Server{}).ginLoggerMiddleware())
+	router.NoRoute(func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		target string
+		// 로그에 기록되어야 하는지 여부
+		logged bool
+	}{
+		{config.Conf.API.MetricURI + "?x=1", false},
+		{config.Conf.API.HealthURI, false},
+		{"/internal", false},
+		// 쿼리 문자열이 있어도 경로만으로 제외 여부 판단
+		{"/internal?debug=1", false},
+		{config.Conf.API.HealthURI + "?verbose=1", false},
+		{"/api/data?y=2", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			buf.Reset()
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			out := buf.String()
+			if tt.logged && !strings.Contains(out, "GET "+tt.target+" ") {
+				t.Fatalf("request %s not logged: %q", tt.target, out)
+			}
+			if !tt.logged && out != "" {
+				t.Fatalf("excluded request %s logged: %q", tt.target, out)
+			}
+		})
+	}
+}
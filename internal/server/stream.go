@@ -0,0 +1,407 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/meloncoffee/weblin/config"
+	"github.com/meloncoffee/weblin/internal/logger"
+	"github.com/meloncoffee/weblin/pkg/utils/resource"
+)
+
+const (
+	// WebSocket 메시지 쓰기 제한 시간
+	wsWriteWait = 10 * time.Second
+	// 클라이언트 pong 응답 대기 시간
+	wsPongWait = 60 * time.Second
+	// ping 전송 주기 (pong 대기 시간보다 짧아야 함)
+	wsPingPeriod = wsPongWait * 9 / 10
+	// 클라이언트로부터 수신하는 메시지 최대 크기 (클라이언트 메시지는 사용하지 않음)
+	wsMaxReadSize = 512
+	// 느린 클라이언트 연결 종료 사유
+	wsReasonSlowConsumer = "slow consumer"
+)
+
+// 느린 클라이언트 처리 방식
+const (
+	// 새 메시지를 버림
+	SlowClientDrop = "drop"
+	// 연결 종료
+	SlowClientDisconnect = "disconnect"
+)
+
+// statsMessage WebSocket으로 전송하는 리소스 사용률 메시지
+type statsMessage struct {
+	Time           time.Time          `json:"time"`
+	CPUUsageRate   float64            `json:"cpuUsageRate"`
+	CPUModeRates   map[string]float64 `json:"cpuModeRates"`
+	MemUsageRate   float64            `json:"memUsageRate"`
+	DiskUsageRates map[string]float64 `json:"diskUsageRates"`
+	Network        []networkMessage   `json:"network"`
+}
+
+// networkMessage 인터페이스별 네트워크 트래픽량 메시지
+type networkMessage struct {
+	Interface   string  `json:"interface"`
+	InboundBps  float64 `json:"inboundBps"`
+	OutboundBps float64 `json:"outboundBps"`
+}
+
+// wsClient WebSocket 클라이언트 정보 구조체
+type wsClient struct {
+	conn *websocket.Conn
+	ip   string
+	// 전송 대기 메시지 (StatsHub에서 제거될 때 닫힘)
+	send chan []byte
+	// 연결 종료 사유 (send가 닫히기 전에 설정)
+	closeReason string
+}
+
+// StatsHub 리소스 사용률을 WebSocket 클라이언트에 전송하는 구조체
+//
+// 샘플러가 Publish로 전달한 사용률을 Run 고루틴에서 모든 클라이언트에 전송하며,
+// 클라이언트별 전송 버퍼가 가득 차면 샘플러를 지연시키지 않도록 정책에 따라 메시지를 버리거나 연결을 종료
+type StatsHub struct {
+	maxClients int
+	sendBuffer int
+	policy     string
+
+	samples chan resource.Usage
+
+	mu      sync.Mutex
+	clients map[*wsClient]struct{}
+	closed  bool
+}
+
+// NewStatsHub StatsHub 생성자
+//
+// Parameters:
+//   - maxClients: 최대 동시 접속 클라이언트 수
+//   - sendBuffer: 클라이언트별 전송 대기 메시지 수
+//   - policy: 느린 클라이언트 처리 방식 (SlowClientDrop, SlowClientDisconnect)
+//
+// Returns:
+//   - *StatsHub: StatsHub 구조체 포인터
+func NewStatsHub(maxClients, sendBuffer int, policy string) *StatsHub {
+	return &StatsHub{
+		maxClients: maxClients,
+		sendBuffer: sendBuffer,
+		policy:     policy,
+		samples:    make(chan resource.Usage, 1),
+		clients:    make(map[*wsClient]struct{}),
+	}
+}
+
+// Publish 전송할 리소스 사용률 전달
+//
+// 샘플러를 지연시키지 않도록 대기하지 않으며, 전송되지 않은 이전 사용률은 새 사용률로 대체
+//
+// Parameters:
+//   - u: 리소스 사용률 정보
+func (h *StatsHub) Publish(u resource.Usage) {
+	for {
+		select {
+		case h.samples <- u:
+			return
+		default:
+			// 아직 전송되지 않은 이전 사용률 제거 후 재시도
+			select {
+			case <-h.samples:
+			default:
+			}
+		}
+	}
+}
+
+// Run 컨텍스트가 종료될 때까지 리소스 사용률을 클라이언트에 전송
+//
+// 종료 시 모든 클라이언트 연결 종료
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+func (h *StatsHub) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			h.closeAll()
+			return
+		case u := <-h.samples:
+			data, err := json.Marshal(newStatsMessage(u))
+			if err != nil {
+				logger.Log.LogWarn("Failed to encode resource usage: %v", err)
+				continue
+			}
+			h.broadcast(data)
+		}
+	}
+}
+
+// broadcast 모든 클라이언트의 전송 버퍼에 메시지 추가
+//
+// Parameters:
+//   - data: 전송할 메시지
+func (h *StatsHub) broadcast(data []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for client := range h.clients {
+		select {
+		case client.send <- data:
+		default:
+			// 전송 버퍼가 가득 찬 느린 클라이언트
+			if h.policy == SlowClientDisconnect {
+				h.removeLocked(client, wsReasonSlowConsumer)
+				continue
+			}
+			logger.Log.LogDebug("WebSocket client too slow, message dropped (IP: %s)", client.ip)
+		}
+	}
+}
+
+// add 클라이언트 등록
+//
+// Parameters:
+//   - client: WebSocket 클라이언트
+//
+// Returns:
+//   - bool: 등록 성공(true), 최대 접속 수 초과 또는 종료 중(false)
+func (h *StatsHub) add(client *wsClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed || len(h.clients) >= h.maxClients {
+		return false
+	}
+	h.clients[client] = struct{}{}
+
+	return true
+}
+
+// remove 클라이언트 등록 해제 및 전송 버퍼 종료
+//
+// Parameters:
+//   - client: WebSocket 클라이언트
+//   - reason: 연결 종료 사유
+func (h *StatsHub) remove(client *wsClient, reason string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.removeLocked(client, reason)
+}
+
+// removeLocked 클라이언트 등록 해제 및 전송 버퍼 종료 (h.mu를 잠근 상태에서 호출)
+//
+// Parameters:
+//   - client: WebSocket 클라이언트
+//   - reason: 연결 종료 사유
+func (h *StatsHub) removeLocked(client *wsClient, reason string) {
+	if _, ok := h.clients[client]; !ok {
+		return
+	}
+	delete(h.clients, client)
+	client.closeReason = reason
+	close(client.send)
+
+	logger.Log.LogInfo("WebSocket client disconnected (IP: %s, reason: %s)", client.ip, reason)
+}
+
+// closeAll 모든 클라이언트 연결 종료 및 신규 접속 차단
+func (h *StatsHub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for client := range h.clients {
+		h.removeLocked(client, "server shutdown")
+	}
+}
+
+// wsStatsHandler 리소스 사용률 WebSocket 핸들러
+//
+// 연결 이후의 송수신은 별도 고루틴에서 처리하므로 핸들러는 업그레이드 후 즉시 반환
+//
+// Parameters:
+//   - c: HTTP 요청 및 응답과 관련된 정보를 포함하는 객체
+func (h *StatsHub) wsStatsHandler(c *gin.Context) {
+	h.mu.Lock()
+	full := h.closed || len(h.clients) >= h.maxClients
+	h.mu.Unlock()
+	if full {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "too many websocket clients"})
+		return
+	}
+
+	upgrader := websocket.Upgrader{CheckOrigin: checkWSOrigin}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// 업그레이드 실패 응답은 Upgrade에서 전송됨
+		logger.Log.LogDebug("Failed to upgrade websocket (IP: %s): %v", c.ClientIP(), err)
+		return
+	}
+
+	client := &wsClient{
+		conn: conn,
+		ip:   c.ClientIP(),
+		send: make(chan []byte, h.sendBuffer),
+	}
+	// 업그레이드 중 다른 클라이언트가 접속하여 최대 접속 수를 초과한 경우
+	if !h.add(client) {
+		conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too many clients"),
+			time.Now().Add(wsWriteWait))
+		conn.Close()
+		return
+	}
+	logger.Log.LogInfo("WebSocket client connected (IP: %s)", client.ip)
+
+	go h.writePump(client)
+	go h.readPump(client)
+}
+
+// writePump 클라이언트 전송 버퍼의 메시지 및 ping 전송
+//
+// 전송 버퍼가 닫히거나 전송에 실패하면 연결을 닫고 종료
+//
+// Parameters:
+//   - client: WebSocket 클라이언트
+func (h *StatsHub) writePump(client *wsClient) {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		client.conn.Close()
+	}()
+
+	for {
+		select {
+		case data, ok := <-client.send:
+			client.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if !ok {
+				// StatsHub에서 제거됨
+				code := websocket.CloseGoingAway
+				if client.closeReason == wsReasonSlowConsumer {
+					code = websocket.ClosePolicyViolation
+				}
+				client.conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(code, client.closeReason))
+				return
+			}
+			if err := client.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				h.remove(client, "write error")
+				return
+			}
+		case <-ticker.C:
+			client.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := client.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				h.remove(client, "write error")
+				return
+			}
+		}
+	}
+}
+
+// readPump 클라이언트 메시지 수신 (연결 종료 및 pong 감지용)
+//
+// 수신에 실패하면 클라이언트를 등록 해제하여 writePump가 종료되도록 함
+//
+// Parameters:
+//   - client: WebSocket 클라이언트
+func (h *StatsHub) readPump(client *wsClient) {
+	client.conn.SetReadLimit(wsMaxReadSize)
+	client.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	client.conn.SetPongHandler(func(string) error {
+		return client.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		if _, _, err := client.conn.ReadMessage(); err != nil {
+			h.remove(client, "client closed")
+			return
+		}
+	}
+}
+
+// checkWSOrigin WebSocket 업그레이드 요청의 Origin 허용 여부 확인
+//
+// api.cors.allowOrigins에 포함된 Origin은 허용하고, 그 외에는 동일 출처 요청만 허용
+//
+// Parameters:
+//   - r: HTTP 요청
+//
+// Returns:
+//   - bool: 허용(true), 거부(false)
+func checkWSOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	allowOrigins := config.Conf.API.CORS.AllowOrigins
+	if slices.Contains(allowOrigins, "*") || slices.Contains(allowOrigins, origin) {
+		return true
+	}
+
+	return sameOrigin(r, origin)
+}
+
+// sameOrigin Origin 헤더의 호스트가 요청 호스트와 같은지 확인
+//
+// Parameters:
+//   - r: HTTP 요청
+//   - origin: Origin 헤더 값
+//
+// Returns:
+//   - bool: 동일 출처(true), 다른 출처(false)
+func sameOrigin(r *http.Request, origin string) bool {
+	_, host, ok := strings.Cut(origin, "://")
+	return ok && strings.EqualFold(host, r.Host)
+}
+
+// newStatsMessage 리소스 사용률 정보로 WebSocket 메시지 생성
+//
+// Parameters:
+//   - u: 리소스 사용률 정보
+//
+// Returns:
+//   - statsMessage: WebSocket 메시지
+func newStatsMessage(u resource.Usage) statsMessage {
+	msg := statsMessage{
+		Time:           time.Now(),
+		CPUUsageRate:   u.CPUUsageRate,
+		CPUModeRates:   u.CPUModeRates,
+		MemUsageRate:   u.MemUsageRate,
+		DiskUsageRates: u.DiskUsageRates,
+		Network:        make([]networkMessage, 0, len(u.NetworkTraffic)),
+	}
+	for _, nt := range u.NetworkTraffic {
+		msg.Network = append(msg.Network, networkMessage{
+			Interface:   nt.Interface,
+			InboundBps:  nt.InboundBps,
+			OutboundBps: nt.OutboundBps,
+		})
+	}
+
+	return msg
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/meloncoffee/weblin/pkg/utils/resource"
+)
+
+// 테스트 대기 타임아웃
+const testWait = 5 * time.Second
+
+// publishMany 리소스 사용률을 여러 번 전달하고 Publish가 지연되지 않는지 확인
+//
+// Parameters:
+//   - t: 테스트 상태
+//   - h: StatsHub
+//   - n: 전달 횟수
+func publishMany(t *testing.T, h *StatsHub, n int) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < n; i++ {
+			h.Publish(resource.Usage{CPUUsageRate: float64(i)})
+			// Run 고루틴이 전송 버퍼를 채울 시간을 줌
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(testWait):
+		t.Fatal("Publish blocked on a stalled client")
+	}
+}
+
+// registered 클라이언트가 StatsHub에 등록되어 있는지 확인
+//
+// Parameters:
+//   - h: StatsHub
+//   - client: WebSocket 클라이언트
+//
+// Returns:
+//   - bool: 등록됨(true), 등록되지 않음(false)
+func registered(h *StatsHub, client *wsClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, ok := h.clients[client]
+	return ok
+}
+
+// TestStatsHubSlowClient 전송 버퍼를 비우지 않는 클라이언트가 있어도 Publish가 지연되지 않고
+// 설정된 정책에 따라 메시지를 버리거나 연결을 종료하는지 확인
+func TestStatsHubSlowClient(t *testing.T) {
+	tests := []struct {
+		policy         string
+		wantRegistered bool
+	}{
+		{SlowClientDrop, true},
+		{SlowClientDisconnect, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			h := NewStatsHub(10, 1, tt.policy)
+			// send 채널을 읽지 않는 클라이언트 (conn은 쓰기 고루틴에서만 사용하므로 nil)
+			client := &wsClient{ip: "192.0.2.1", send: make(chan []byte, 1)}
+			if !h.add(client) {
+				t.Fatal("failed to add client")
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go h.Run(ctx)
+
+			publishMany(t, h, 20)
+
+			if tt.wantRegistered {
+				// 버퍼를 넘는 메시지는 버려지고 연결은 유지됨
+				time.Sleep(50 * time.Millisecond)
+				if !registered(h, client) {
+					t.Fatal("client was removed under drop policy")
+				}
+				if len(client.send) != cap(client.send) {
+					t.Fatalf("send buffer has %d messages, want %d", len(client.send), cap(client.send))
+				}
+				return
+			}
+
+			// 연결 종료 정책이면 등록 해제되고 전송 버퍼가 닫힘
+			deadline := time.Now().Add(testWait)
+			for registered(h, client) {
+				if time.Now().After(deadline) {
+					t.Fatal("slow client was not removed under disconnect policy")
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			if client.closeReason != wsReasonSlowConsumer {
+				t.Fatalf("close reason = %q, want %q", client.closeReason, wsReasonSlowConsumer)
+			}
+			for range client.send {
+				// 남은 메시지를 비우고 채널이 닫혔는지 확인
+			}
+		})
+	}
+}
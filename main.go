@@ -19,8 +19,13 @@ Package main weblin 메인 패키지
 */
 package main
 
-import "github.com/meloncoffee/weblin/cmd"
+import (
+	"github.com/meloncoffee/weblin/cmd"
+	"github.com/meloncoffee/weblin/pkg/utils/process"
+)
 
 func main() {
+	// 프로세스 타이틀 변경에 대비하여 인자 파싱 전에 argv 영역과 os.Args 분리
+	process.PrepareProcTitle()
 	cmd.Execute()
 }
@@ -20,11 +20,22 @@ Package file 파일 관련 공용 함수 패키지
 package file
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"syscall"
 )
 
+// DefaultDirPerm 디렉터리 생성 시 기본 권한 (rwxr-xr-x)
+const DefaultDirPerm os.FileMode = 0755
+
+// ErrLocked 다른 프로세스가 이미 파일 잠금을 보유하고 있음
+var ErrLocked = errors.New("file is locked by another process")
+
 // WriteDataToTextFile 제네릭한 파일 쓰기 함수
 // Parameters:
 //   - filePath: 파일 경로
@@ -34,12 +45,40 @@ import (
 // Returns:
 //   - error: 성공(nil), 실패(error)
 func WriteDataToTextFile[T any](filePath string, data T, isMakeDir bool) error {
+	return writeDataToTextFile(filePath, data, isMakeDir, false)
+}
+
+// WriteDataToTextFileSync 디스크 동기화를 보장하는 제네릭한 파일 쓰기 함수
+//
+// 파일을 닫기 전에 fsync를 호출하므로, 쓰기 직후 전원이 차단되어도 기록한 내용이 유지됨
+//
+// Parameters:
+//   - filePath: 파일 경로
+//   - data: 제네릭 타입 데이터
+//   - isMakeDir: 디렉터리가 존재하지 않을 경우 생성 옵션
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func WriteDataToTextFileSync[T any](filePath string, data T, isMakeDir bool) error {
+	return writeDataToTextFile(filePath, data, isMakeDir, true)
+}
+
+// writeDataToTextFile 제네릭한 파일 쓰기 공통 함수
+//
+// Parameters:
+//   - filePath: 파일 경로
+//   - data: 제네릭 타입 데이터
+//   - isMakeDir: 디렉터리가 존재하지 않을 경우 생성 옵션
+//   - fsync: 파일을 닫기 전에 디스크 동기화 여부
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func writeDataToTextFile[T any](filePath string, data T, isMakeDir, fsync bool) error {
 	if isMakeDir {
 		// 디렉터리가 존재하지 않을 경우 생성
-		dir := filepath.Dir(filePath)
-		err := os.MkdirAll(dir, os.ModePerm)
+		err := EnsureDir(filepath.Dir(filePath), DefaultDirPerm)
 		if err != nil {
-			return fmt.Errorf("failed to make directory: %v", err)
+			return err
 		}
 	}
 
@@ -54,9 +93,135 @@ func WriteDataToTextFile[T any](filePath string, data T, isMakeDir bool) error {
 		return fmt.Errorf("failed to write file: %v", err)
 	}
 
+	if fsync {
+		err = file.Sync()
+		if err != nil {
+			return fmt.Errorf("failed to sync file: %v", err)
+		}
+	}
+
 	return nil
 }
 
+// AtomicWriteFile 파일 원자적 쓰기 함수
+//
+// 같은 디렉터리의 임시 파일에 데이터를 기록한 후 rename하므로,
+// 다른 프로세스가 읽는 시점에 비어 있거나 일부만 기록된 파일을 보지 않음
+//
+// Parameters:
+//   - filePath: 파일 경로
+//   - data: 기록할 데이터
+//   - perm: 파일 권한
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func AtomicWriteFile(filePath string, data []byte, perm os.FileMode) error {
+	// rename이 원자적으로 수행되도록 대상 파일과 같은 디렉터리에 임시 파일 생성
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %v", err)
+	}
+	tmpPath := tmp.Name()
+
+	// 실패 시 임시 파일 정리
+	success := false
+	defer func() {
+		if !success {
+			tmp.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return fmt.Errorf("failed to write temp file: %v", err)
+	}
+	if err = tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync temp file: %v", err)
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		return fmt.Errorf("failed to change temp file mode: %v", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temp file: %v", err)
+	}
+
+	if err = os.Rename(tmpPath, filePath); err != nil {
+		return fmt.Errorf("failed to rename temp file: %v", err)
+	}
+	success = true
+
+	// 전원 차단 시에도 rename 결과가 유지되도록 디렉터리 동기화
+	if err = syncDir(filepath.Dir(filePath)); err != nil {
+		return fmt.Errorf("failed to sync directory: %v", err)
+	}
+
+	return nil
+}
+
+// syncDir 디렉터리 엔트리 변경 사항을 디스크에 동기화
+//
+// Parameters:
+//   - dirPath: 디렉터리 경로
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func syncDir(dirPath string) error {
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	return dir.Sync()
+}
+
+// LockFile 파일에 배타적 잠금(flock) 설정
+//
+// 잠금은 반환된 파일을 닫거나 프로세스가 종료될 때까지 유지되며,
+// 다른 프로세스가 이미 잠금을 보유하고 있으면 대기하지 않고 ErrLocked 반환
+//
+// Parameters:
+//   - filePath: 잠금 파일 경로 (없으면 디렉터리와 함께 생성)
+//
+// Returns:
+//   - *os.File: 잠금이 설정된 파일
+//   - error: 성공(nil), 잠금 보유 중(ErrLocked), 실패(error)
+func LockFile(filePath string) (*os.File, error) {
+	// 잠금 파일 디렉터리 생성
+	err := EnsureDir(filepath.Dir(filePath), DefaultDirPerm)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %v", err)
+	}
+
+	err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, ErrLocked
+		}
+		return nil, fmt.Errorf("failed to lock file: %v", err)
+	}
+
+	return file, nil
+}
+
+// UnlockFile LockFile로 설정한 잠금 해제
+//
+// Parameters:
+//   - file: 잠금이 설정된 파일
+func UnlockFile(file *os.File) {
+	if file == nil {
+		return
+	}
+	syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+	file.Close()
+}
+
 // IsFileExists 파일 존재 여부 확인
 //
 // Parameters:
@@ -71,3 +236,66 @@ func IsFileExists(filePath string) bool {
 	}
 	return !stat.IsDir()
 }
+
+// IsDirExists 디렉터리 존재 여부 확인
+//
+// Parameters:
+//   - dirPath: 디렉터리 경로
+//
+// Returns:
+//   - bool: 디렉터리 존재(true), 디렉터리 미존재(false)
+func IsDirExists(dirPath string) bool {
+	stat, err := os.Stat(dirPath)
+	if err != nil {
+		return false
+	}
+	return stat.IsDir()
+}
+
+// EnsureDir 디렉터리가 존재하지 않을 경우 상위 디렉터리를 포함하여 생성
+//
+// 이미 존재하는 디렉터리의 권한은 변경하지 않음
+//
+// Parameters:
+//   - dirPath: 디렉터리 경로
+//   - perm: 생성할 디렉터리 권한 (umask 적용)
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func EnsureDir(dirPath string, perm os.FileMode) error {
+	if IsDirExists(dirPath) {
+		return nil
+	}
+
+	err := os.MkdirAll(dirPath, perm)
+	if err != nil {
+		return fmt.Errorf("failed to make directory: %v", err)
+	}
+
+	return nil
+}
+
+// FileSHA256 파일 내용의 SHA-256 해시 계산
+//
+// 파일 전체를 메모리에 올리지 않고 스트리밍 방식으로 계산
+//
+// Parameters:
+//   - filePath: 파일 경로
+//
+// Returns:
+//   - string: 16진수 문자열로 표현한 SHA-256 해시
+//   - error: 성공(nil), 실패(error)
+func FileSHA256(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %v", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to read file: %v", err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+// TestEnsureDir 상위 디렉터리를 포함하여 기본 권한으로 생성하고 기존 디렉터리 권한은 유지하는지 확인
+func TestEnsureDir(t *testing.T) {
+	// 실행 환경의 umask와 무관하게 생성 권한을 확인하도록 umask 고정
+	oldMask := syscall.Umask(022)
+	t.Cleanup(func() { syscall.Umask(oldMask) })
+
+	base := t.TempDir()
+	dirPath := filepath.Join(base, "a", "b")
+	if err := EnsureDir(dirPath, DefaultDirPerm); err != nil {
+		t.Fatalf("EnsureDir failed: %v", err)
+	}
+	for _, p := range []string{filepath.Join(base, "a"), dirPath} {
+		stat, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("stat %s failed: %v", p, err)
+		}
+		if !stat.IsDir() {
+			t.Fatalf("%s is not a directory", p)
+		}
+		if perm := stat.Mode().Perm(); perm != DefaultDirPerm {
+			t.Fatalf("%s mode = %o, want %o", p, perm, DefaultDirPerm)
+		}
+	}
+
+	// 이미 존재하는 디렉터리의 권한은 변경하지 않음
+	existing := filepath.Join(base, "existing")
+	if err := os.Mkdir(existing, 0700); err != nil {
+		t.Fatalf("failed to make directory: %v", err)
+	}
+	if err := EnsureDir(existing, DefaultDirPerm); err != nil {
+		t.Fatalf("EnsureDir on existing directory failed: %v", err)
+	}
+	stat, err := os.Stat(existing)
+	if err != nil {
+		t.Fatalf("stat %s failed: %v", existing, err)
+	}
+	if perm := stat.Mode().Perm(); perm != 0700 {
+		t.Fatalf("existing mode = %o, want %o", perm, 0700)
+	}
+
+	// 같은 경로에 일반 파일이 있으면 에러
+	filePath := filepath.Join(base, "file")
+	if err := os.WriteFile(filePath, nil, 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := EnsureDir(filePath, DefaultDirPerm); err == nil {
+		t.Fatal("EnsureDir succeeded on a regular file")
+	}
+}
+
+// TestIsDirExists 디렉터리, 일반 파일, 존재하지 않는 경로에 대한 결과 확인
+func TestIsDirExists(t *testing.T) {
+	base := t.TempDir()
+	filePath := filepath.Join(base, "file")
+	if err := os.WriteFile(filePath, nil, 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{"directory", base, true},
+		{"regular file", filePath, false},
+		{"missing", filepath.Join(base, "missing"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDirExists(tt.path); got != tt.want {
+				t.Fatalf("IsDirExists(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFileSHA256 알려진 내용의 파일에 대해 SHA-256 해시가 일치하는지 확인
+func TestFileSHA256(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"hello", "hello\n", "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"},
+		{"empty", "", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "data")
+			if err := os.WriteFile(filePath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+
+			got, err := FileSHA256(filePath)
+			if err != nil {
+				t.Fatalf("FileSHA256 failed: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("FileSHA256 = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := FileSHA256(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Fatal("FileSHA256 succeeded on a missing file")
+	}
+}
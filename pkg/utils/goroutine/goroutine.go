@@ -23,29 +23,76 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // PanicHandleFunc 패닉 핸들러 함수 타입 정의
 type PanicHandleFunc func(interface{})
 
+// RestartHandleFunc 패닉 발생 작업 재시작 핸들러 함수 타입 정의
+//
+// Parameters:
+//   - name: 작업명
+//   - panicErr: 패닉 에러
+//   - restart: 재시작 횟수 (1부터 시작)
+type RestartHandleFunc func(name string, panicErr interface{}, restart int)
+
+// 패닉 재시작 대기 시간 최대값
+const maxRestartBackoff = time.Minute
+
+// TaskOptions 작업 등록 옵션
+type TaskOptions struct {
+	// 패닉 발생 시 작업 재시작 여부
+	RestartOnPanic bool
+	// 최대 재시작 횟수 (초과 시 PanicHandler 호출 후 재시작 중단)
+	MaxRestarts int
+	// 첫 재시작 대기 시간 (재시작마다 2배씩 증가, 최대 1분)
+	RestartBackoff time.Duration
+	// 작업 최대 실행 시간 (0이면 제한 없음, 가동할 때마다 적용)
+	Timeout time.Duration
+	// 선행 작업명 리스트 (StartAll에서 선행 작업이 준비된 후 가동)
+	DependsOn []string
+	// 준비 완료 신호 사용 여부
+	// true: 작업이 MarkReady를 호출할 때 준비 완료, false: 가동 즉시 준비 완료
+	SignalsReady bool
+}
+
+// readyKey 작업 컨텍스트에 저장되는 준비 완료 함수 키
+type readyKey struct{}
+
 // GoroutineManager 전체 고루틴 관리 정보 구조체
 type GoroutineManager struct {
+	// 작업 패닉 핸들러 (재시작하는 패닉은 RestartHandler 호출)
 	PanicHandler PanicHandleFunc
-	mu           sync.Mutex
-	parentWG     sync.WaitGroup
-	parentCtx    context.Context
-	parentCancel context.CancelFunc
-	tasks        map[string]*taskWrapper
+	// 패닉 발생 작업 재시작 핸들러 (nil이면 DefaultRestartHandler 사용)
+	RestartHandler RestartHandleFunc
+	mu             sync.Mutex
+	parentWG       sync.WaitGroup
+	parentCtx      context.Context
+	parentCancel   context.CancelFunc
+	tasks          map[string]*taskWrapper
 }
 
 // taskWrapper 개별 고루틴 관리 정보 구조체
+//
+// 가동할 때마다 컨텍스트와 종료 채널을 새로 생성하여 이전 가동 주기의 상태를 재사용하지 않음
 type taskWrapper struct {
-	childWG     sync.WaitGroup
-	childCtx    context.Context
+	task func(ctx context.Context)
+	opts TaskOptions
+	// 현재 가동 주기의 작업 종료 함수 (가동 전이면 nil)
 	childCancel context.CancelFunc
-	task        func(ctx context.Context)
+	// 현재 가동 주기의 작업 종료 시 닫히는 채널 (가동 전이면 nil)
+	done chan struct{}
+	// 현재 가동 주기의 작업 준비 완료 시 닫히는 채널 (가동 전이면 nil)
+	ready chan struct{}
+	// 작업 가동 여부 (종료 처리 시 gm.mu를 잠그지 않도록 atomic 사용)
+	running atomic.Bool
+	// 작업 등록 이후 패닉으로 인한 누적 재시작 횟수
+	restarts atomic.Uint64
 }
 
 // NewGoroutineManager 고루틴 관리 구조체 생성
@@ -68,16 +115,48 @@ func NewGoroutineManager() *GoroutineManager {
 //   - name: 작업명 (key)
 //   - task: function (value)
 func (gm *GoroutineManager) AddTask(name string, task func(ctx context.Context)) {
+	gm.AddTaskWithOptions(name, task, TaskOptions{})
+}
+
+// AddTaskWithTimeout 최대 실행 시간을 지정하여 고루틴을 작업에 등록
+//
+// 작업 컨텍스트는 종료 요청 또는 타임아웃 중 먼저 발생한 시점에 종료되며,
+// 작업 함수는 ctx.Done()을 확인하여 스스로 종료해야 함
+//
+// Parameters:
+//   - name: 작업명 (key)
+//   - timeout: 작업 최대 실행 시간
+//   - task: function (value)
+func (gm *GoroutineManager) AddTaskWithTimeout(name string, timeout time.Duration, task func(ctx context.Context)) {
+	gm.AddTaskWithOptions(name, task, TaskOptions{Timeout: timeout})
+}
+
+// AddTaskWithDeps 선행 작업을 지정하여 고루틴을 작업에 등록
+//
+// 선행 작업이 모두 준비된 후 StartAll에서 작업을 가동
+//
+// Parameters:
+//   - name: 작업명 (key)
+//   - deps: 선행 작업명 리스트
+//   - task: function (value)
+func (gm *GoroutineManager) AddTaskWithDeps(name string, deps []string, task func(ctx context.Context)) {
+	gm.AddTaskWithOptions(name, task, TaskOptions{DependsOn: deps})
+}
+
+// AddTaskWithOptions 옵션을 지정하여 고루틴을 작업에 등록
+//
+// Parameters:
+//   - name: 작업명 (key)
+//   - task: function (value)
+//   - opts: 작업 등록 옵션
+func (gm *GoroutineManager) AddTaskWithOptions(name string, task func(ctx context.Context), opts TaskOptions) {
 	gm.mu.Lock()
 	defer gm.mu.Unlock()
 
-	// 개별 고루틴 종료를 위한 자식 컨텍스트 생성
-	ctx, cancel := context.WithCancel(gm.parentCtx)
 	// 맵에 작업 등록
 	gm.tasks[name] = &taskWrapper{
-		childCtx:    ctx,
-		childCancel: cancel,
-		task:        task,
+		task: task,
+		opts: opts,
 	}
 }
 
@@ -85,7 +164,7 @@ func (gm *GoroutineManager) AddTask(name string, task func(ctx context.Context))
 //
 // Parameters:
 //   - name: 작업명
-//   - timeout: WaitGroup 타임아웃
+//   - timeout: 작업 종료 대기 타임아웃
 //
 // Returns:
 //   - error: 성공(nil), 타임아웃 발생(error)
@@ -94,10 +173,8 @@ func (gm *GoroutineManager) RemoveTask(name string, timeout time.Duration) error
 	defer gm.mu.Unlock()
 
 	if t, exists := gm.tasks[name]; exists {
-		t.childCancel()
-		if WaitGroupWithTimeout(&t.childWG, timeout) != WaitSuccess {
-			return fmt.Errorf("goroutine was not terminated within the specified timeout"+
-				"(goroutine: %s, timeout: %.2fsec)", name, timeout.Seconds())
+		if err := t.stop(name, timeout); err != nil {
+			return err
 		}
 		delete(gm.tasks, name)
 	}
@@ -106,29 +183,131 @@ func (gm *GoroutineManager) RemoveTask(name string, timeout time.Duration) error
 }
 
 // StartAll 작업에 등록된 모든 고루틴 가동
-func (gm *GoroutineManager) StartAll() {
+//
+// 선행 작업 의존 관계에 따라 위상 정렬 순서로 가동하며, 선행 작업이 준비 완료(또는 종료)될 때까지
+// 대기한 후 후행 작업을 가동. 이미 가동 중인 작업은 중복 가동하지 않고 건너뜀.
+//
+// Returns:
+//   - error: 성공(nil), 존재하지 않는 선행 작업 또는 순환 의존 관계(error)
+func (gm *GoroutineManager) StartAll() error {
+	return gm.StartAllContext(context.Background())
+}
+
+// StartAllContext 컨텍스트 종료 시 선행 작업 대기를 중단하는 StartAll
+//
+// 선행 작업이 준비되지 않은 채 멈춘 경우에도 호출자가 컨텍스트를 종료하여 가동을 중단할 수 있으며,
+// 이때 아직 가동하지 않은 작업은 가동하지 않음
+//
+// Parameters:
+//   - ctx: 가동 중단 컨텍스트
+//
+// Returns:
+//   - error: 성공(nil), 존재하지 않는 선행 작업, 순환 의존 관계 또는 가동 중단(error)
+func (gm *GoroutineManager) StartAllContext(ctx context.Context) error {
 	gm.mu.Lock()
-	defer gm.mu.Unlock()
+	order, err := gm.startOrder()
+	gm.mu.Unlock()
+	if err != nil {
+		return err
+	}
 
-	for _, t := range gm.tasks {
-		gm.parentWG.Add(1)
-		t.childWG.Add(1)
-		tmpTask := t
-		go func(tw *taskWrapper) {
-			defer func() {
-				if err := recover(); err != nil {
-					if gm.PanicHandler != nil {
-						gm.PanicHandler(err)
-					}
-				}
-				tw.childWG.Done()
-				gm.parentWG.Done()
-			}()
+	for _, name := range order {
+		gm.mu.Lock()
+		t, exists := gm.tasks[name]
+		if !exists || t.running.Load() {
+			gm.mu.Unlock()
+			continue
+		}
+		// 선행 작업의 현재 가동 주기 채널 획득
+		var readies, dones []chan struct{}
+		for _, dep := range t.opts.DependsOn {
+			if d := gm.tasks[dep]; d != nil && d.ready != nil {
+				readies = append(readies, d.ready)
+				dones = append(dones, d.done)
+			}
+		}
+		gm.mu.Unlock()
+
+		// 선행 작업 준비 완료 대기 (잠금 없이 대기)
+		for i := range readies {
+			select {
+			case <-readies[i]:
+			case <-dones[i]:
+			case <-ctx.Done():
+				return fmt.Errorf("stopped while waiting for dependencies of task %s", name)
+			case <-gm.parentCtx.Done():
+				return fmt.Errorf("stopped while waiting for dependencies of task %s", name)
+			}
+		}
+
+		gm.mu.Lock()
+		// 대기 중 가동 중단 또는 전체 정지 요청이 있었으면 가동하지 않음
+		if ctx.Err() != nil || gm.parentCtx.Err() != nil {
+			gm.mu.Unlock()
+			return fmt.Errorf("stopped before starting task %s", name)
+		}
+		if !t.running.Load() {
+			gm.start(name, t)
+		}
+		gm.mu.Unlock()
+	}
+
+	return nil
+}
+
+// startOrder 선행 작업 의존 관계에 따른 작업 가동 순서 계산 (gm.mu를 잠근 상태에서 호출)
+//
+// Returns:
+//   - []string: 작업 가동 순서
+//   - error: 성공(nil), 존재하지 않는 선행 작업 또는 순환 의존 관계(error)
+func (gm *GoroutineManager) startOrder() ([]string, error) {
+	// 동일한 순서로 가동되도록 작업명 정렬
+	names := make([]string, 0, len(gm.tasks))
+	for name := range gm.tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(names))
+	order := make([]string, 0, len(names))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("task dependency cycle detected (%s -> %s)",
+				strings.Join(path, " -> "), name)
+		}
+
+		state[name] = visiting
+		for _, dep := range gm.tasks[name].opts.DependsOn {
+			if _, exists := gm.tasks[dep]; !exists {
+				return fmt.Errorf("task %s depends on unknown task (%s)", name, dep)
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
 
-			// 작업 가동
-			tw.task(tw.childCtx)
-		}(tmpTask)
+		return nil
 	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
 }
 
 // StopAll 작업에 등록된 모든 고루틴 가동 정지
@@ -152,6 +331,8 @@ func (gm *GoroutineManager) StopAll(timeout time.Duration) error {
 
 // Start 작업에 등록된 개별 고루틴 가동
 //
+// 선행 작업 준비 완료를 기다리지 않고 즉시 가동
+//
 // Parameters:
 //   - name: 작업명
 //
@@ -166,32 +347,142 @@ func (gm *GoroutineManager) Start(name string) error {
 	if !exists {
 		return fmt.Errorf("task does not exist (%s)", name)
 	}
+	// 이미 가동 중인 작업은 중복 가동하지 않음
+	if t.running.Load() {
+		return fmt.Errorf("task already running (%s)", name)
+	}
+
+	gm.start(name, t)
+
+	return nil
+}
+
+// start 새 컨텍스트와 종료 채널로 작업 가동 (gm.mu를 잠근 상태에서 호출)
+//
+// Parameters:
+//   - name: 작업명
+//   - t: 개별 고루틴 관리 정보
+func (gm *GoroutineManager) start(name string, t *taskWrapper) {
+	// 개별 고루틴 종료를 위한 자식 컨텍스트 생성 (최대 실행 시간이 있으면 타임아웃 설정)
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(gm.parentCtx, t.opts.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(gm.parentCtx)
+	}
+	done := make(chan struct{})
+	t.childCancel = cancel
+	t.done = done
+
+	// 준비 완료 신호 설정 (신호를 사용하지 않는 작업은 즉시 준비 완료)
+	ready := make(chan struct{})
+	var readyOnce sync.Once
+	markReady := func() {
+		readyOnce.Do(func() { close(ready) })
+	}
+	t.ready = ready
+	if !t.opts.SignalsReady {
+		markReady()
+	}
+	ctx = context.WithValue(ctx, readyKey{}, markReady)
 
 	gm.parentWG.Add(1)
-	t.childWG.Add(1)
+	t.running.Store(true)
 	go func() {
-		defer func() {
-			if err := recover(); err != nil {
-				if gm.PanicHandler != nil {
-					gm.PanicHandler(err)
-				}
-			}
-			t.childWG.Done()
-			gm.parentWG.Done()
-		}()
+		// 작업이 스스로 종료된 경우에도 컨텍스트 자원(타이머 등) 해제
+		defer cancel()
+		gm.run(ctx, name, t, done)
+	}()
+}
 
-		// 작업 가동
-		t.task(t.childCtx)
+// run 작업 가동 (고루틴으로 호출)
+//
+// 패닉 발생 시 RestartOnPanic 옵션이 설정된 작업은 대기 후 최대 재시작 횟수까지 재가동
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+//   - name: 작업명
+//   - tw: 개별 고루틴 관리 정보
+//   - done: 작업 종료 시 닫을 채널
+func (gm *GoroutineManager) run(ctx context.Context, name string, tw *taskWrapper, done chan struct{}) {
+	defer func() {
+		tw.running.Store(false)
+		close(done)
+		gm.parentWG.Done()
 	}()
 
-	return nil
+	backoff := tw.opts.RestartBackoff
+	for restart := 1; ; restart++ {
+		panicErr, panicked := gm.runOnce(ctx, tw)
+		if !panicked {
+			return
+		}
+
+		// 재시작하지 않는 작업이거나 종료 중이면 패닉 핸들러 호출 후 종료
+		if !tw.opts.RestartOnPanic || ctx.Err() != nil {
+			gm.handlePanic(panicErr)
+			return
+		}
+		// 최대 재시작 횟수 초과 시 재시작 중단
+		if restart > tw.opts.MaxRestarts {
+			gm.handlePanic(fmt.Errorf("task %s exceeded max restarts (%d), giving up: %v",
+				name, tw.opts.MaxRestarts, panicErr))
+			return
+		}
+
+		tw.restarts.Add(1)
+		if gm.RestartHandler != nil {
+			gm.RestartHandler(name, panicErr, restart)
+		} else {
+			DefaultRestartHandler(name, panicErr, restart)
+		}
+
+		// 재시작 대기 (대기 중 작업 종료 요청 시 종료)
+		if WaitCancelWithTimeout(ctx, backoff) == WaitSuccess {
+			return
+		}
+		backoff = min(backoff*2, maxRestartBackoff)
+	}
+}
+
+// runOnce 작업 함수 1회 실행 및 패닉 복구
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+//   - tw: 개별 고루틴 관리 정보
+//
+// Returns:
+//   - interface{}: 패닉 에러
+//   - bool: 패닉 발생(true), 정상 종료(false)
+func (gm *GoroutineManager) runOnce(ctx context.Context, tw *taskWrapper) (panicErr interface{}, panicked bool) {
+	defer func() {
+		if err := recover(); err != nil {
+			panicErr, panicked = err, true
+		}
+	}()
+
+	// 작업 가동
+	tw.task(ctx)
+
+	return nil, false
+}
+
+// handlePanic 패닉 핸들러 호출
+//
+// Parameters:
+//   - panicErr: 패닉 에러
+func (gm *GoroutineManager) handlePanic(panicErr interface{}) {
+	if gm.PanicHandler != nil {
+		gm.PanicHandler(panicErr)
+	}
 }
 
 // Stop 작업에 등록된 개별 고루틴 가동 정지
 //
 // Parameters:
 //   - name: 작업명
-//   - timeout: WaitGroup 타임아웃
+//   - timeout: 작업 종료 대기 타임아웃
 //
 // Returns:
 //   - error: 성공(nil), 타임아웃 발생(error)
@@ -200,15 +491,106 @@ func (gm *GoroutineManager) Stop(name string, timeout time.Duration) error {
 	defer gm.mu.Unlock()
 
 	if t, exists := gm.tasks[name]; exists {
-		t.childCancel()
-		if WaitGroupWithTimeout(&t.childWG, timeout) != WaitSuccess {
-			return fmt.Errorf("goroutine was not terminated within the specified timeout"+
-				"(goroutine: %s, timeout: %.2fsec)", name, timeout.Seconds())
-		}
+		return t.stop(name, timeout)
+	}
+	return nil
+}
+
+// stop 현재 가동 주기의 작업 종료 및 대기 (gm.mu를 잠근 상태에서 호출)
+//
+// Parameters:
+//   - name: 작업명
+//   - timeout: 작업 종료 대기 타임아웃
+//
+// Returns:
+//   - error: 성공(nil), 타임아웃 발생(error)
+func (t *taskWrapper) stop(name string, timeout time.Duration) error {
+	// 가동된 적 없는 작업
+	if t.done == nil {
+		return nil
+	}
+
+	t.childCancel()
+	if WaitDoneWithTimeout(t.done, timeout) != WaitSuccess {
+		return fmt.Errorf("goroutine was not terminated within the specified timeout"+
+			"(goroutine: %s, timeout: %.2fsec)", name, timeout.Seconds())
 	}
 	return nil
 }
 
+// MarkReady 작업 준비 완료 신호 전송
+//
+// SignalsReady 옵션으로 등록된 작업이 초기화를 마친 후 호출하며,
+// GoroutineManager가 가동한 작업 컨텍스트가 아니면 아무 동작도 하지 않음
+//
+// Parameters:
+//   - ctx: 작업 컨텍스트
+func MarkReady(ctx context.Context) {
+	if markReady, ok := ctx.Value(readyKey{}).(func()); ok {
+		markReady()
+	}
+}
+
+// ListTasks 등록된 작업명 리스트 반환
+//
+// Returns:
+//   - []string: 작업명 리스트 (이름순 정렬)
+func (gm *GoroutineManager) ListTasks() []string {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	names := make([]string, 0, len(gm.tasks))
+	for name := range gm.tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// IsRunning 작업 가동 여부 확인
+//
+// Parameters:
+//   - name: 작업명
+//
+// Returns:
+//   - bool: 가동 중(true), 정지 또는 미등록(false)
+func (gm *GoroutineManager) IsRunning(name string) bool {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	t, exists := gm.tasks[name]
+	return exists && t.running.Load()
+}
+
+// Restarts 작업이 패닉으로 재시작된 누적 횟수 반환
+//
+// Parameters:
+//   - name: 작업명
+//
+// Returns:
+//   - uint64: 누적 재시작 횟수 (미등록 작업은 0)
+func (gm *GoroutineManager) Restarts(name string) uint64 {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	t, exists := gm.tasks[name]
+	if !exists {
+		return 0
+	}
+	return t.restarts.Load()
+}
+
+// DefaultRestartHandler 기본 패닉 발생 작업 재시작 핸들러 함수
+//
+// Parameters:
+//   - name: 작업명
+//   - panicErr: 패닉 에러
+//   - restart: 재시작 횟수
+func DefaultRestartHandler(name string, panicErr interface{}, restart int) {
+	fmt.Fprintf(os.Stderr, "panic occurred in task %s, restarting (restart: %d): %v\n", name, restart, panicErr)
+}
+
 // DefaultPanicHandler 기본 패닉 핸들러 함수
 //
 // Parameters:
@@ -0,0 +1,193 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package goroutine
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// 테스트 작업 종료 대기 타임아웃
+const testTimeout = 5 * time.Second
+
+// TestStartAllContextCancelWhileWaiting 선행 작업이 준비되지 않은 상태에서 가동 중단 시
+// StartAllContext가 반환되고 후행 작업은 가동되지 않는지 확인
+func TestStartAllContextCancelWhileWaiting(t *testing.T) {
+	gm := NewGoroutineManager()
+	defer gm.StopAll(testTimeout)
+
+	// MarkReady를 호출하지 않는 선행 작업
+	gm.AddTaskWithOptions("dep", func(ctx context.Context) {
+		<-ctx.Done()
+	}, TaskOptions{SignalsReady: true})
+
+	started := make(chan struct{}, 1)
+	gm.AddTaskWithDeps("dependent", []string{"dep"}, func(ctx context.Context) {
+		started <- struct{}{}
+		<-ctx.Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- gm.StartAllContext(ctx)
+	}()
+
+	// 선행 작업 준비 대기 중인지 확인
+	select {
+	case err := <-errCh:
+		t.Fatalf("StartAllContext returned before cancel: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err == nil {
+			t.Fatal("StartAllContext returned nil after cancel")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("StartAllContext did not return after cancel")
+	}
+
+	select {
+	case <-started:
+		t.Fatal("dependent task started after cancel")
+	default:
+	}
+	if gm.IsRunning("dependent") {
+		t.Fatal("dependent task is running after cancel")
+	}
+}
+
+// TestStartStopRepeatedly 같은 작업을 반복하여 가동/정지해도 경합이나 WaitGroup 오용 패닉이 없는지 확인
+// (go test -race로 실행)
+func TestStartStopRepeatedly(t *testing.T) {
+	gm := NewGoroutineManager()
+	defer gm.StopAll(testTimeout)
+
+	gm.AddTask("task", func(ctx context.Context) {
+		<-ctx.Done()
+	})
+
+	for i := 0; i < 2000; i++ {
+		if err := gm.Start("task"); err != nil {
+			t.Fatalf("Start failed at iteration %d: %v", i, err)
+		}
+		if err := gm.Stop("task", testTimeout); err != nil {
+			t.Fatalf("Stop failed at iteration %d: %v", i, err)
+		}
+	}
+
+	if gm.IsRunning("task") {
+		t.Fatal("task is running after final Stop")
+	}
+}
+
+// TestStopWhileTaskExits 작업이 스스로 종료되는 시점과 Stop이 겹쳐도 정지 후 다시 가동되는지 확인
+func TestStopWhileTaskExits(t *testing.T) {
+	gm := NewGoroutineManager()
+	defer gm.StopAll(testTimeout)
+
+	// 가동 직후 스스로 종료되는 작업
+	gm.AddTask("task", func(ctx context.Context) {})
+
+	for i := 0; i < 2000; i++ {
+		if err := gm.Start("task"); err != nil {
+			t.Fatalf("Start failed at iteration %d: %v", i, err)
+		}
+		if err := gm.Stop("task", testTimeout); err != nil {
+			t.Fatalf("Stop failed at iteration %d: %v", i, err)
+		}
+	}
+}
+
+// countingTask 가동 횟수를 세고 컨텍스트 종료까지 대기하는 테스트 작업 생성
+//
+// Parameters:
+//   - runs: 가동 횟수를 전달할 채널
+//
+// Returns:
+//   - func(ctx context.Context): 작업 함수
+func countingTask(runs chan<- struct{}) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		runs <- struct{}{}
+		<-ctx.Done()
+	}
+}
+
+// assertRunsOnce 작업 함수가 정확히 한 번 가동되었는지 확인
+//
+// Parameters:
+//   - t: 테스트 상태
+//   - runs: 가동 횟수를 전달받는 채널
+func assertRunsOnce(t *testing.T, runs <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-runs:
+	case <-time.After(testTimeout):
+		t.Fatal("task did not run")
+	}
+	select {
+	case <-runs:
+		t.Fatal("task ran more than once")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestDoubleStart 이미 가동 중인 작업을 다시 가동해도 작업 함수가 한 번만 실행되는지 확인
+func TestDoubleStart(t *testing.T) {
+	startAll := func(gm *GoroutineManager) error { return gm.StartAll() }
+	start := func(gm *GoroutineManager) error { return gm.Start("task") }
+
+	tests := []struct {
+		name          string
+		first, second func(gm *GoroutineManager) error
+		// 두 번째 가동에서 반환해야 하는 에러 메시지 (빈 문자열이면 nil)
+		wantErr string
+	}{
+		{"StartAll twice", startAll, startAll, ""},
+		{"StartAll then Start", startAll, start, "task already running (task)"},
+		{"Start twice", start, start, "task already running (task)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gm := NewGoroutineManager()
+			defer gm.StopAll(testTimeout)
+
+			runs := make(chan struct{}, 10)
+			gm.AddTask("task", countingTask(runs))
+
+			if err := tt.first(gm); err != nil {
+				t.Fatalf("first start failed: %v", err)
+			}
+
+			err := tt.second(gm)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("second start failed: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("second start error = %v, want %q", err, tt.wantErr)
+			}
+
+			assertRunsOnce(t, runs)
+		})
+	}
+}
@@ -91,3 +91,32 @@ func WaitGroupWithTimeout(wg *sync.WaitGroup, timeout time.Duration) WaitError {
 		return WaitTimeout
 	}
 }
+
+// WaitDoneWithTimeout 종료 채널이 닫힐 때까지 타임아웃 대기
+//
+// Parameters:
+//   - done: 작업 종료 시 닫히는 채널
+//   - timeout: 타임아웃
+//
+// Returns:
+//   - WaitError: 채널 닫힘(WaitSuccess), 실패(WaitError)
+func WaitDoneWithTimeout(done <-chan struct{}, timeout time.Duration) WaitError {
+	if done == nil {
+		return WaitInvalidParam
+	}
+
+	// 타임아웃이 0보다 작을 경우 무한 대기
+	if timeout < 0 {
+		<-done
+		return WaitSuccess
+	}
+
+	select {
+	case <-done:
+		// 작업 정상 종료
+		return WaitSuccess
+	case <-time.After(timeout):
+		// 타임아웃 발생
+		return WaitTimeout
+	}
+}
@@ -23,9 +23,43 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
 	"syscall"
+	"unsafe"
 )
 
+// CapNetBindService 1024 미만 포트 바인딩 권한 (linux/capability.h)
+const CapNetBindService = 10
+
+// 데몬화된 자식 프로세스임을 표시하는 환경 변수 (재실행 전에 설정)
+const daemonEnvKey = "WEBLIN_DAEMONIZED"
+
+// 프로세스 타이틀로 덮어쓸 argv 메모리 영역 (PrepareProcTitle 호출 시 설정)
+var argvArea []byte
+
+// 시그널명과 시그널 번호 매핑
+var signalNames = map[string]syscall.Signal{
+	"SIGABRT":   syscall.SIGABRT,
+	"SIGALRM":   syscall.SIGALRM,
+	"SIGFPE":    syscall.SIGFPE,
+	"SIGHUP":    syscall.SIGHUP,
+	"SIGILL":    syscall.SIGILL,
+	"SIGINT":    syscall.SIGINT,
+	"SIGPIPE":   syscall.SIGPIPE,
+	"SIGPROF":   syscall.SIGPROF,
+	"SIGQUIT":   syscall.SIGQUIT,
+	"SIGTERM":   syscall.SIGTERM,
+	"SIGTSTP":   syscall.SIGTSTP,
+	"SIGTTIN":   syscall.SIGTTIN,
+	"SIGTTOU":   syscall.SIGTTOU,
+	"SIGUSR1":   syscall.SIGUSR1,
+	"SIGUSR2":   syscall.SIGUSR2,
+	"SIGVTALRM": syscall.SIGVTALRM,
+	"SIGWINCH":  syscall.SIGWINCH,
+}
+
 // IsProcessRun 프로세스가 동작 중인지 확인
 //
 // Parameters:
@@ -47,36 +81,51 @@ func IsProcessRun(pid int) bool {
 
 // DaemonizeProcess 데몬 프로세스 생성
 //
+// 부모 프로세스가 자식 프로세스 재실행 전에 환경 변수를 설정하고, 자식 프로세스는 이 환경 변수로
+// 데몬화 여부를 판단. systemd, docker 등에서는 포그라운드 실행 시에도 PPID가 1일 수 있으므로
+// PPID로 판단하지 않음.
+//
+// Parameters:
+//   - extraArgs: 자식 프로세스에 현재 실행 인자 뒤에 추가로 전달할 인자
+//
 // Returns:
 //   - error: 성공(nil), 실패(error)
-func DaemonizeProcess() error {
-	// PID가 1인 경우 이미 데몬 프로세스임
-	if os.Getppid() != 1 {
-		// 현재 프로세스의 절대 경로 획득
-		exePath, err := os.Executable()
-		if err != nil {
-			return fmt.Errorf("failed to get absolute path: %v", err)
-		}
+func DaemonizeProcess(extraArgs ...string) error {
+	// 환경 변수가 설정되어 있으면 이미 데몬 프로세스임
+	// (데몬이 실행하는 하위 프로세스에 상속되지 않도록 제거)
+	if os.Getenv(daemonEnvKey) == "1" {
+		os.Unsetenv(daemonEnvKey)
+		return nil
+	}
 
-		// 자식 프로세스 생성
-		cmd := exec.Command(exePath, os.Args[1:]...)
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			Setsid: true,
-		}
-		cmd.Stdin = nil
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
+	// 현재 프로세스의 절대 경로 획득
+	exePath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get absolute path: %v", err)
+	}
 
-		// 데몬 프로세스 가동
-		err = cmd.Start()
-		if err != nil {
-			return fmt.Errorf("failed to start daemon process: %v", err)
-		}
+	// 자식 프로세스 생성
+	args := append(slices.Clone(os.Args[1:]), extraArgs...)
+	cmd := exec.Command(exePath, args...)
+	cmd.Env = append(os.Environ(), daemonEnvKey+"=1")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true,
+	}
+	// 데몬은 터미널과 분리되므로 표준 입출력을 /dev/null로 연결 (nil이면 os/exec가 /dev/null을 연결)
+	// 라이브러리가 표준 출력에 쓰더라도 유효한 파일 디스크립터이므로 패닉이 발생하지 않음
+	cmd.Stdin = nil
+	cmd.Stdout = nil
+	cmd.Stderr = nil
 
-		// 부모 프로세스 종료
-		os.Exit(0)
+	// 데몬 프로세스 가동
+	err = cmd.Start()
+	if err != nil {
+		return fmt.Errorf("failed to start daemon process: %v", err)
 	}
 
+	// 부모 프로세스 종료
+	os.Exit(0)
+
 	return nil
 }
 
@@ -103,3 +152,121 @@ func SendSignal(pid int, sig syscall.Signal) error {
 
 	return nil
 }
+
+// HasCapability 현재 프로세스의 유효(effective) capability 보유 여부 확인
+//
+// Parameters:
+//   - capNum: capability 번호 (ex: CapNetBindService)
+//
+// Returns:
+//   - bool: 보유(true), 미보유(false)
+//   - error: 성공(nil), 실패(error)
+func HasCapability(capNum uint) (bool, error) {
+	data, err := os.ReadFile("/proc/self/status")
+	if err != nil {
+		return false, err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "CapEff:" {
+			continue
+		}
+
+		capEff, err := strconv.ParseUint(fields[1], 16, 64)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse CapEff: %v", err)
+		}
+		return capEff&(1<<capNum) != 0, nil
+	}
+
+	return false, fmt.Errorf("CapEff not found")
+}
+
+// ParseSignal 시그널명을 시그널 번호로 변환
+//
+// 대소문자를 구분하지 않으며 "SIG" 접두사는 생략 가능 (ex: "hup", "SIGHUP")
+//
+// Parameters:
+//   - name: 시그널명
+//
+// Returns:
+//   - syscall.Signal: 시그널 번호
+//   - error: 성공(nil), 실패(error)
+func ParseSignal(name string) (syscall.Signal, error) {
+	upper := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(upper, "SIG") {
+		upper = "SIG" + upper
+	}
+
+	sig, ok := signalNames[upper]
+	if !ok {
+		return 0, fmt.Errorf("unsupported signal (%s)", name)
+	}
+	return sig, nil
+}
+
+// PrepareProcTitle 프로세스 타이틀 변경을 위해 argv 메모리 영역을 기록하고 os.Args를 복사
+//
+// os.Args의 문자열은 프로세스 시작 시 커널이 할당한 argv 메모리 영역을 그대로 참조하며,
+// 인자 파싱 과정에서 잘라낸 부분 문자열(플래그 값 등)도 같은 영역을 참조함.
+// 이후 SetProcTitle이 덮어쓴 영역을 참조하는 문자열이 남지 않도록
+// 인자를 파싱하기 전(main 함수 시작 시)에 호출해야 함.
+func PrepareProcTitle() {
+	if len(os.Args) == 0 || argvArea != nil {
+		return
+	}
+
+	// argv 메모리 영역 계산 (argv[0] 시작 주소부터 마지막 인자의 끝까지 연속된 영역)
+	last := os.Args[len(os.Args)-1]
+	start := unsafe.StringData(os.Args[0])
+	end := unsafe.Add(unsafe.Pointer(unsafe.StringData(last)), len(last))
+	size := int(uintptr(end) - uintptr(unsafe.Pointer(start)))
+	if start == nil || size <= 0 {
+		return
+	}
+
+	// os.Args 문자열을 별도 메모리로 복사하여 argv 영역과의 참조를 끊음
+	for i := range os.Args {
+		os.Args[i] = strings.Clone(os.Args[i])
+	}
+	argvArea = unsafe.Slice(start, size)
+}
+
+// SetProcTitle ps/top 등에 표시되는 프로세스 타이틀 변경
+//
+// 메인 스레드의 comm(/proc/self/comm, 최대 15 byte)을 변경하고,
+// PrepareProcTitle에서 기록한 argv 메모리 영역을 덮어써서 cmdline을 변경.
+// argv 영역보다 긴 타이틀은 잘림.
+//
+// Parameters:
+//   - title: 프로세스 타이틀
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func SetProcTitle(title string) error {
+	if title == "" {
+		return nil
+	}
+
+	// comm 변경 (/proc/self/comm은 스레드 그룹 리더(메인 스레드)를 가리킴)
+	comm := title
+	if len(comm) > 15 {
+		comm = comm[:15]
+	}
+	err := os.WriteFile("/proc/self/comm", []byte(comm), 0)
+	if err != nil {
+		return fmt.Errorf("failed to set comm: %v", err)
+	}
+
+	// 인자 파싱 이후에는 argv 영역을 참조하는 문자열이 남아 있을 수 있으므로 덮어쓰지 않음
+	if argvArea == nil {
+		return fmt.Errorf("argv memory area not prepared")
+	}
+
+	// argv 영역을 타이틀로 덮어쓰고 나머지는 NUL로 채움
+	n := copy(argvArea, title)
+	clear(argvArea[n:])
+
+	return nil
+}
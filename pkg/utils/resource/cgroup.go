@@ -0,0 +1,61 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// cgroup v1에서 메모리 제한이 없을 때 설정되는 값의 하한 (페이지 크기로 정렬된 int64 최대값 근사)
+const cgroupV1Unlimited = 1 << 62
+
+// GetCgroupMemoryLimit 현재 프로세스가 속한 cgroup의 메모리 제한 획득
+//
+// cgroup v2(memory.max)를 우선 확인하고, 없으면 cgroup v1(memory.limit_in_bytes)을 확인
+//
+// Returns:
+//   - uint64: 메모리 제한 (byte, 제한이 없으면 0)
+//   - error: 성공(nil), 실패(error)
+func GetCgroupMemoryLimit() (uint64, error) {
+	// cgroup v2
+	data, err := os.ReadFile("/sys/fs/cgroup/memory.max")
+	if err == nil {
+		value := strings.TrimSpace(string(data))
+		if value == "max" {
+			return 0, nil
+		}
+		return strconv.ParseUint(value, 10, 64)
+	}
+
+	// cgroup v1
+	data, err = os.ReadFile("/sys/fs/cgroup/memory/memory.limit_in_bytes")
+	if err == nil {
+		limit, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if limit >= cgroupV1Unlimited {
+			return 0, nil
+		}
+		return limit, nil
+	}
+
+	return 0, fmt.Errorf("cgroup memory limit not found")
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"context"
+)
+
+// runContext 리소스 획득 함수를 컨텍스트 종료와 함께 대기
+//
+// 멈춘 파일 시스템 등으로 파일 읽기나 시스템 콜이 반환되지 않아도 컨텍스트가 종료되면 즉시 반환.
+// 이 경우 획득 함수를 실행하는 고루틴은 시스템 콜이 반환될 때까지 남아 있으며 결과는 버려짐.
+// 종료되지 않는 컨텍스트(context.Background 등)는 고루틴 없이 바로 실행.
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//   - fn: 리소스 획득 함수
+//
+// Returns:
+//   - T: 리소스 획득 결과
+//   - error: 성공(nil), 실패 또는 컨텍스트 종료(error)
+func runContext[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	var zero T
+	if ctx.Done() == nil {
+		return fn()
+	}
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	// 컨텍스트 종료 후 결과를 받지 않아도 고루틴이 블로킹되지 않도록 버퍼 사용
+	resultCh := make(chan result, 1)
+	go func() {
+		value, err := fn()
+		resultCh <- result{value: value, err: err}
+	}()
+
+	select {
+	case res := <-resultCh:
+		return res.value, res.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// GetCPUStatContext 컨텍스트 종료 시 대기를 중단하는 GetCPUStat
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//
+// Returns:
+//   - CPUStat: CPU 상태 정보 구조체
+//   - error: 성공(nil), 실패 또는 컨텍스트 종료(error)
+func GetCPUStatContext(ctx context.Context) (CPUStat, error) {
+	return runContext(ctx, GetCPUStat)
+}
+
+// GetMemStatContext 컨텍스트 종료 시 대기를 중단하는 GetMemStat
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//
+// Returns:
+//   - MemStat: 메모리 상태 정보 구조체
+//   - error: 성공(nil), 실패 또는 컨텍스트 종료(error)
+func GetMemStatContext(ctx context.Context) (MemStat, error) {
+	return runContext(ctx, GetMemStat)
+}
+
+// GetDiskStatContext 컨텍스트 종료 시 대기를 중단하는 GetDiskStat
+//
+// 응답하지 않는 NFS 등의 파일 시스템에서 statfs 호출로 종료가 지연되지 않도록 사용
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//   - path: 디스크 사용률 측정 기준 경로
+//
+// Returns:
+//   - DiskStat: 디스크 상태 정보 구조체
+//   - error: 성공(nil), 실패 또는 컨텍스트 종료(error)
+func GetDiskStatContext(ctx context.Context, path string) (DiskStat, error) {
+	return runContext(ctx, func() (DiskStat, error) {
+		return GetDiskStat(path)
+	})
+}
+
+// GetAllNetworkTrafficContext 컨텍스트 종료 시 대기를 중단하는 GetAllNetworkTraffic
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//   - excludePrefixes: 제외할 인터페이스명 접두사 리스트
+//
+// Returns:
+//   - []NetworkTraffic: 인터페이스 별 네트워크 트래픽 정보 리스트
+//   - error: 성공(nil), 실패 또는 컨텍스트 종료(error)
+func GetAllNetworkTrafficContext(ctx context.Context, excludePrefixes ...string) ([]NetworkTraffic, error) {
+	return runContext(ctx, func() ([]NetworkTraffic, error) {
+		return GetAllNetworkTraffic(excludePrefixes...)
+	})
+}
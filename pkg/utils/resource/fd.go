@@ -0,0 +1,47 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// GetFDStat 현재 프로세스의 열린 파일 디스크립터 수와 최대 개수 획득
+//
+// Returns:
+//   - open: 열린 파일 디스크립터 수 (/proc/self/fd 엔트리 수)
+//   - max: 열 수 있는 파일 디스크립터 최대 개수 (RLIMIT_NOFILE soft limit)
+//   - err: 성공(nil), 실패(error)
+func GetFDStat() (open uint64, max uint64, err error) {
+	// /proc/<pid>/fd 읽기에 사용하는 디스크립터도 포함되므로 1개를 제외
+	entries, err := os.ReadDir(fmt.Sprintf("/proc/%d/fd", os.Getpid()))
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(entries) > 0 {
+		open = uint64(len(entries) - 1)
+	}
+
+	var rlimit syscall.Rlimit
+	if err = syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlimit); err != nil {
+		return 0, 0, fmt.Errorf("failed to get RLIMIT_NOFILE: %v", err)
+	}
+
+	return open, rlimit.Cur, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LoadAvg 시스템 부하 평균 정보 구조체
+type LoadAvg struct {
+	Load1     float64 // 1분 부하 평균
+	Load5     float64 // 5분 부하 평균
+	Load15    float64 // 15분 부하 평균
+	NrRunning uint64  // 현재 실행 가능한(running) 스케줄링 엔티티 수
+	NrTotal   uint64  // 시스템에 존재하는 전체 스케줄링 엔티티 수
+}
+
+// GetLoadAvg 시스템 부하 평균 정보 획득
+//
+// Returns:
+//   - LoadAvg: 시스템 부하 평균 정보 구조체
+//   - error: 성공(nil), 실패(error)
+func GetLoadAvg() (LoadAvg, error) {
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return LoadAvg{}, err
+	}
+
+	return parseLoadAvg(string(data))
+}
+
+// parseLoadAvg /proc/loadavg 내용 파싱
+//
+// 형식: "0.52 0.58 0.59 2/1234 56789"
+//
+// Parameters:
+//   - data: /proc/loadavg 파일 내용
+//
+// Returns:
+//   - LoadAvg: 시스템 부하 평균 정보 구조체
+//   - error: 성공(nil), 실패(error)
+func parseLoadAvg(data string) (LoadAvg, error) {
+	fields := strings.Fields(data)
+	if len(fields) < 5 {
+		return LoadAvg{}, fmt.Errorf("unexpected loadavg format: %d fields", len(fields))
+	}
+
+	var loadAvg LoadAvg
+	var err error
+
+	// 부하 평균 파싱
+	loads := []*float64{&loadAvg.Load1, &loadAvg.Load5, &loadAvg.Load15}
+	for i, load := range loads {
+		*load, err = strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return LoadAvg{}, fmt.Errorf("invalid load average %q: %v", fields[i], err)
+		}
+	}
+
+	// running/total 필드 파싱
+	running, total, found := strings.Cut(fields[3], "/")
+	if !found {
+		return LoadAvg{}, fmt.Errorf("invalid running/total field %q", fields[3])
+	}
+	loadAvg.NrRunning, err = strconv.ParseUint(running, 10, 64)
+	if err != nil {
+		return LoadAvg{}, fmt.Errorf("invalid running count %q: %v", running, err)
+	}
+	loadAvg.NrTotal, err = strconv.ParseUint(total, 10, 64)
+	if err != nil {
+		return LoadAvg{}, fmt.Errorf("invalid total count %q: %v", total, err)
+	}
+
+	return loadAvg, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// OOM kill 커널 메시지 패턴 (ex: "Out of memory: Killed process 1234 (java) ...")
+var oomKillPattern = regexp.MustCompile(`Killed process (\d+) \(([^)]*)\)`)
+
+// OOMEvent OOM killer에 의해 종료된 프로세스 정보 구조체
+type OOMEvent struct {
+	Pid     int    // 종료된 프로세스 PID
+	Name    string // 종료된 프로세스명
+	Message string // 커널 메시지 원문
+}
+
+// OOMWatcher 커널 링 버퍼(/dev/kmsg)의 OOM kill 메시지 감시 구조체
+type OOMWatcher struct {
+	mu       sync.Mutex
+	started  bool   // 최초 조회 여부
+	lastSeq  uint64 // 마지막으로 확인한 메시지 시퀀스 번호
+	kills    uint64 // 감시 시작 이후 확인된 OOM kill 횟수
+	disabled bool   // 권한 부족 등으로 /dev/kmsg 조회 불가 여부
+}
+
+// GetOOMKillCount 부팅 이후 OOM kill 횟수 획득 (/proc/vmstat의 oom_kill)
+//
+// Returns:
+//   - uint64: OOM kill 횟수
+//   - error: 성공(nil), 실패(error)
+func GetOOMKillCount() (uint64, error) {
+	data, err := os.ReadFile("/proc/vmstat")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	// oom_kill 카운터는 커널 4.13 이상에서만 제공됨
+	return 0, fmt.Errorf("oom_kill counter not found")
+}
+
+// Poll 마지막 조회 이후 새로 기록된 OOM kill 메시지 조회
+//
+// 최초 호출 시에는 현재까지의 메시지를 기준점으로만 삼고 이벤트를 반환하지 않음.
+// /dev/kmsg 조회 권한이 없으면 이후 호출부터 조회하지 않음.
+//
+// Returns:
+//   - []OOMEvent: 새로 확인된 OOM kill 이벤트 리스트
+//   - error: 성공(nil), 실패(error)
+func (w *OOMWatcher) Poll() ([]OOMEvent, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.disabled {
+		return nil, nil
+	}
+
+	// 비블로킹 모드로 열어서 남은 레코드가 없으면 EAGAIN을 받도록 함
+	fd, err := syscall.Open("/dev/kmsg", syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		if errors.Is(err, syscall.EPERM) || errors.Is(err, syscall.EACCES) ||
+			errors.Is(err, syscall.ENOENT) {
+			w.disabled = true
+		}
+		return nil, fmt.Errorf("failed to open /dev/kmsg: %v", err)
+	}
+	defer syscall.Close(fd)
+
+	var events []OOMEvent
+	var maxSeq uint64
+	buf := make([]byte, 8192)
+
+	for {
+		n, err := syscall.Read(fd, buf)
+		if err != nil {
+			// 읽는 도중 레코드가 덮어써진 경우 다음 레코드부터 계속 읽음
+			if errors.Is(err, syscall.EPIPE) {
+				continue
+			}
+			if errors.Is(err, syscall.EAGAIN) {
+				break
+			}
+			if errors.Is(err, syscall.EPERM) || errors.Is(err, syscall.EACCES) {
+				w.disabled = true
+			}
+			return nil, fmt.Errorf("failed to read /dev/kmsg: %v", err)
+		}
+		if n <= 0 {
+			break
+		}
+
+		// 레코드 형식: "<prio>,<seq>,<timestamp>,<flags>;<message>"
+		record := string(buf[:n])
+		sep := strings.IndexByte(record, ';')
+		if sep == -1 {
+			continue
+		}
+		prefix := strings.Split(record[:sep], ",")
+		if len(prefix) < 2 {
+			continue
+		}
+		seq, err := strconv.ParseUint(prefix[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if seq > maxSeq {
+			maxSeq = seq
+		}
+		if w.started && seq <= w.lastSeq {
+			continue
+		}
+
+		// 메시지 본문은 첫 번째 라인까지만 사용 (이후 라인은 부가 정보)
+		message := record[sep+1:]
+		if idx := strings.IndexByte(message, '\n'); idx != -1 {
+			message = message[:idx]
+		}
+
+		match := oomKillPattern.FindStringSubmatch(message)
+		if match == nil {
+			continue
+		}
+		pid, _ := strconv.Atoi(match[1])
+		events = append(events, OOMEvent{
+			Pid:     pid,
+			Name:    match[2],
+			Message: message,
+		})
+	}
+
+	if maxSeq > w.lastSeq {
+		w.lastSeq = maxSeq
+	}
+
+	// 최초 조회는 기준점 설정용
+	if !w.started {
+		w.started = true
+		return nil, nil
+	}
+
+	w.kills += uint64(len(events))
+	return events, nil
+}
+
+// Kills 감시 시작 이후 확인된 OOM kill 횟수 반환
+//
+// Returns:
+//   - uint64: OOM kill 횟수
+func (w *OOMWatcher) Kills() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.kills
+}
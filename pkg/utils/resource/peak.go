@@ -0,0 +1,178 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"sync"
+	"time"
+)
+
+// PeakTracker 설정된 구간 동안의 리소스 사용률 최대값 추적 구조체
+type PeakTracker struct {
+	mu      sync.Mutex
+	window  time.Duration // 최대값 추적 구간
+	rolling bool          // 슬라이딩 구간(true), 고정 구간(false) 여부
+	start   time.Time     // 고정 구간 시작 시간
+	samples []peakSample  // 구간 내 리소스 사용률 샘플
+}
+
+// peakSample 시간 정보를 포함하는 리소스 사용률 샘플
+type peakSample struct {
+	at    time.Time
+	usage Usage
+}
+
+// 기본 최대값 추적 (24시간 슬라이딩 구간)
+var peaks = NewPeakTracker(24*time.Hour, true)
+
+// NewPeakTracker 최대값 추적 구조체 생성
+//
+// Parameters:
+//   - window: 최대값 추적 구간
+//   - rolling: 슬라이딩 구간(true) - 항상 최근 window 동안의 최대값,
+//     고정 구간(false) - window가 지날 때마다 최대값 초기화
+//
+// Returns:
+//   - *PeakTracker
+func NewPeakTracker(window time.Duration, rolling bool) *PeakTracker {
+	return &PeakTracker{
+		window:  window,
+		rolling: rolling,
+		start:   time.Now(),
+	}
+}
+
+// Add 리소스 사용률 샘플 추가
+//
+// Parameters:
+//   - u: 리소스 사용률 정보
+func (p *PeakTracker) Add(u Usage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	p.expire(now)
+
+	// 최대값 계산에 필요한 값만 저장
+	p.samples = append(p.samples, peakSample{
+		at: now,
+		usage: Usage{
+			CPUUsageRate:   u.CPUUsageRate,
+			MemUsageRate:   u.MemUsageRate,
+			DiskUsageRates: u.DiskUsageRates,
+			NetworkTraffic: u.NetworkTraffic,
+		},
+	})
+}
+
+// Peak 구간 내 리소스 사용률 최대값 반환
+//
+// 디스크 사용률은 경로 별, 네트워크 트래픽량은 인터페이스 별 최대값을 반환
+//
+// Returns:
+//   - Usage: 리소스 사용률 최대값
+func (p *PeakTracker) Peak() Usage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.expire(time.Now())
+
+	peak := Usage{DiskUsageRates: make(map[string]float64)}
+	netPeaks := make(map[string]*NetworkTraffic)
+	var names []string
+
+	for _, sample := range p.samples {
+		peak.CPUUsageRate = max(peak.CPUUsageRate, sample.usage.CPUUsageRate)
+		peak.MemUsageRate = max(peak.MemUsageRate, sample.usage.MemUsageRate)
+		for path, rate := range sample.usage.DiskUsageRates {
+			peak.DiskUsageRates[path] = max(peak.DiskUsageRates[path], rate)
+		}
+
+		for _, traffic := range sample.usage.NetworkTraffic {
+			np, exists := netPeaks[traffic.Interface]
+			if !exists {
+				np = &NetworkTraffic{Interface: traffic.Interface}
+				netPeaks[traffic.Interface] = np
+				names = append(names, traffic.Interface)
+			}
+			np.InboundBps = max(np.InboundBps, traffic.InboundBps)
+			np.OutboundBps = max(np.OutboundBps, traffic.OutboundBps)
+		}
+	}
+
+	for _, name := range names {
+		peak.NetworkTraffic = append(peak.NetworkTraffic, *netPeaks[name])
+	}
+
+	return peak
+}
+
+// Configure 최대값 추적 구간 변경 (기존 샘플은 초기화)
+//
+// Parameters:
+//   - window: 최대값 추적 구간
+//   - rolling: 슬라이딩 구간(true), 고정 구간(false) 여부
+func (p *PeakTracker) Configure(window time.Duration, rolling bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.window = window
+	p.rolling = rolling
+	p.start = time.Now()
+	p.samples = nil
+}
+
+// expire 구간을 벗어난 샘플 제거 (mu 잠금 상태에서 호출)
+//
+// Parameters:
+//   - now: 현재 시간
+func (p *PeakTracker) expire(now time.Time) {
+	if !p.rolling {
+		// 고정 구간이 지나면 전체 초기화
+		if now.Sub(p.start) >= p.window {
+			p.start = now
+			p.samples = nil
+		}
+		return
+	}
+
+	// 샘플은 시간 순으로 저장되므로 구간 내 첫 샘플을 찾아 앞부분 제거
+	idx := 0
+	for idx < len(p.samples) && now.Sub(p.samples[idx].at) > p.window {
+		idx++
+	}
+	if idx > 0 {
+		p.samples = append(p.samples[:0], p.samples[idx:]...)
+	}
+}
+
+// ConfigurePeaks 리소스 사용률 최대값 추적 구간 설정
+//
+// Parameters:
+//   - window: 최대값 추적 구간
+//   - rolling: 슬라이딩 구간(true), 고정 구간(false) 여부
+func ConfigurePeaks(window time.Duration, rolling bool) {
+	peaks.Configure(window, rolling)
+}
+
+// GetPeakUsage 구간 내 리소스 사용률 최대값 획득
+//
+// Returns:
+//   - Usage: 리소스 사용률 최대값
+func GetPeakUsage() Usage {
+	return peaks.Peak()
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// GetProcessCount 실행 중인 프로세스 수와 전체 프로세스 수 획득
+//
+// Returns:
+//   - running: 실행 가능한(running) 상태의 프로세스 수 (/proc/stat의 procs_running)
+//   - total: 전체 프로세스 수 (/proc의 PID 디렉터리 수)
+//   - err: 성공(nil), 실패(error)
+func GetProcessCount() (running, total int, err error) {
+	return countProcesses("/proc")
+}
+
+// countProcesses 지정된 proc 파일 시스템 경로 기준으로 프로세스 수 획득
+//
+// Parameters:
+//   - procRoot: proc 파일 시스템 경로 (ex: /proc)
+//
+// Returns:
+//   - running: 실행 가능한(running) 상태의 프로세스 수
+//   - total: 전체 프로세스 수
+//   - err: 성공(nil), 실패(error)
+func countProcesses(procRoot string) (running, total int, err error) {
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// 숫자로 된 디렉터리만 PID로 취급 (self, net 등은 제외)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := strconv.Atoi(entry.Name()); err == nil {
+			total++
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(procRoot, "stat"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "procs_running" {
+			running, err = strconv.Atoi(fields[1])
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid procs_running %q: %v", fields[1], err)
+			}
+			return running, total, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("procs_running not found")
+}
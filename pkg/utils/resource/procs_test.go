@@ -0,0 +1,62 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCountProcesses 숫자로 된 디렉터리만 PID로 세고 procs_running 값을 읽는지 확인
+func TestCountProcesses(t *testing.T) {
+	root := t.TempDir()
+
+	// PID 디렉터리 3개와 PID가 아닌 항목 (self, net 디렉터리, 숫자 이름의 일반 파일)
+	for _, dir := range []string{"1", "42", "1337", "self", "net"} {
+		if err := os.Mkdir(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "99"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	stat := "cpu  1 2 3 4 5 6 7 8 0 0\nprocesses 1000\nprocs_running 2\nprocs_blocked 0\n"
+	if err := os.WriteFile(filepath.Join(root, "stat"), []byte(stat), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	running, total, err := countProcesses(root)
+	if err != nil {
+		t.Fatalf("countProcesses failed: %v", err)
+	}
+	if running != 2 || total != 3 {
+		t.Fatalf("got (running %d, total %d), want (running 2, total 3)", running, total)
+	}
+}
+
+// TestCountProcessesMissingProcsRunning stat 파일에 procs_running 라인이 없으면 에러를 반환하는지 확인
+func TestCountProcessesMissingProcsRunning(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "stat"), []byte("processes 1000\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := countProcesses(root); err == nil {
+		t.Fatal("countProcesses returned nil error without procs_running")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// ProcessStat 프로세스 리소스 상태 정보 구조체
+type ProcessStat struct {
+	Pid                   int    // 프로세스 ID
+	Utime                 uint64 // 사용자 모드에서 사용한 CPU 시간 (clock tick)
+	Stime                 uint64 // 커널 모드에서 사용한 CPU 시간 (clock tick)
+	RssBytes              uint64 // 상주 메모리 크기 (byte)
+	NumThreads            uint64 // 스레드 수
+	VoluntaryCtxtSwitches uint64 // 자발적 컨텍스트 스위치 횟수
+}
+
+// GetProcessStat 지정된 프로세스의 리소스 상태 정보 획득
+//
+// Parameters:
+//   - pid: 프로세스 ID
+//
+// Returns:
+//   - ProcessStat: 프로세스 리소스 상태 정보 구조체
+//   - error: 성공(nil), 실패(error)
+func GetProcessStat(pid int) (ProcessStat, error) {
+	procStat := ProcessStat{Pid: pid}
+
+	// CPU 시간 및 스레드 수 획득
+	data, err := readProcFile(pid, "stat")
+	if err != nil {
+		return ProcessStat{}, err
+	}
+	if err := parseProcessStat(data, &procStat); err != nil {
+		return ProcessStat{}, fmt.Errorf("failed to parse /proc/%d/stat: %v", pid, err)
+	}
+
+	// 메모리 및 컨텍스트 스위치 정보 획득
+	data, err = readProcFile(pid, "status")
+	if err != nil {
+		return ProcessStat{}, err
+	}
+	if err := parseProcessStatus(data, &procStat); err != nil {
+		return ProcessStat{}, fmt.Errorf("failed to parse /proc/%d/status: %v", pid, err)
+	}
+
+	return procStat, nil
+}
+
+// readProcFile /proc/<pid>/<name> 파일 읽기
+//
+// Parameters:
+//   - pid: 프로세스 ID
+//   - name: 파일명
+//
+// Returns:
+//   - string: 파일 내용
+//   - error: 성공(nil), 실패(error)
+func readProcFile(pid int, name string) (string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/%s", pid, name))
+	if err != nil {
+		// 샘플링 사이에 프로세스가 종료된 경우
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, syscall.ESRCH) {
+			return "", fmt.Errorf("process %d no longer exists", pid)
+		}
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", fmt.Errorf("process %d no longer exists", pid)
+	}
+	return string(data), nil
+}
+
+// parseProcessStat /proc/<pid>/stat 내용 파싱
+//
+// 프로세스명(comm)에 공백이나 괄호가 포함될 수 있으므로 마지막 ')' 이후부터 필드를 분리
+//
+// Parameters:
+//   - data: /proc/<pid>/stat 파일 내용
+//   - procStat: 파싱 결과를 저장할 구조체
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func parseProcessStat(data string, procStat *ProcessStat) error {
+	idx := strings.LastIndexByte(data, ')')
+	if idx < 0 {
+		return fmt.Errorf("malformed stat line")
+	}
+
+	// fields[0]은 stat 파일의 3번째 필드(state)
+	fields := strings.Fields(data[idx+1:])
+	if len(fields) < 18 {
+		return fmt.Errorf("unexpected field count: %d", len(fields))
+	}
+
+	var err error
+	if procStat.Utime, err = strconv.ParseUint(fields[11], 10, 64); err != nil {
+		return fmt.Errorf("invalid utime: %v", err)
+	}
+	if procStat.Stime, err = strconv.ParseUint(fields[12], 10, 64); err != nil {
+		return fmt.Errorf("invalid stime: %v", err)
+	}
+	if procStat.NumThreads, err = strconv.ParseUint(fields[17], 10, 64); err != nil {
+		return fmt.Errorf("invalid num_threads: %v", err)
+	}
+
+	return nil
+}
+
+// parseProcessStatus /proc/<pid>/status 내용 파싱
+//
+// Parameters:
+//   - data: /proc/<pid>/status 파일 내용
+//   - procStat: 파싱 결과를 저장할 구조체
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func parseProcessStatus(data string, procStat *ProcessStat) error {
+	var foundCtxt bool
+
+	for _, line := range strings.Split(data, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch key {
+		case "VmRSS":
+			// 커널 스레드는 VmRSS 항목이 없음
+			rss, err := strconv.ParseUint(fields[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid VmRSS: %v", err)
+			}
+			procStat.RssBytes = rss * 1024
+		case "voluntary_ctxt_switches":
+			switches, err := strconv.ParseUint(fields[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid voluntary_ctxt_switches: %v", err)
+			}
+			procStat.VoluntaryCtxtSwitches = switches
+			foundCtxt = true
+		}
+	}
+
+	if !foundCtxt {
+		return fmt.Errorf("voluntary_ctxt_switches not found")
+	}
+
+	return nil
+}
+
+// CalculateProcessCPURate 프로세스 CPU 사용률 계산
+//
+// 시스템 전체 CPU 시간 변화량 대비 프로세스가 사용한 CPU 시간의 비율을 계산
+//
+// Parameters:
+//   - prev: 이전 프로세스 리소스 상태 정보
+//   - current: 현재 프로세스 리소스 상태 정보
+//   - totalCPUDiff: 같은 구간 동안의 시스템 전체 CPU 시간 변화량 (clock tick)
+//
+// Returns:
+//   - float64: 프로세스 CPU 사용률
+func CalculateProcessCPURate(prev, current ProcessStat, totalCPUDiff uint64) float64 {
+	prevTime := prev.Utime + prev.Stime
+	currentTime := current.Utime + current.Stime
+
+	// PID 재사용 등으로 누적값이 감소한 경우
+	if totalCPUDiff == 0 || currentTime < prevTime {
+		return 0.0
+	}
+
+	return (float64(currentTime-prevTime) / float64(totalCPUDiff)) * 100
+}
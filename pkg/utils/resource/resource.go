@@ -29,11 +29,14 @@ import (
 
 // CPUStat CPU 상태 정보 구조체
 type CPUStat struct {
-	User   uint64 // 사용자 모드에서 실행된 프로세스가 사용한 시간 (일반 우선순위)
-	Nice   uint64 // 낮은 우선순위(NICE)로 실행된 프로세스가 사용한 시간
-	System uint64 // 시스템 모드(커널)에서 실행된 작업이 사용한 시간
-	Idle   uint64 // CPU가 유휴 상태로 대기한 시간
-	IOWait uint64 // 디스크, 네트워크 등의 I/O 작업을 기다리며 대기한 시간
+	User    uint64 // 사용자 모드에서 실행된 프로세스가 사용한 시간 (일반 우선순위)
+	Nice    uint64 // 낮은 우선순위(NICE)로 실행된 프로세스가 사용한 시간
+	System  uint64 // 시스템 모드(커널)에서 실행된 작업이 사용한 시간
+	Idle    uint64 // CPU가 유휴 상태로 대기한 시간
+	IOWait  uint64 // 디스크, 네트워크 등의 I/O 작업을 기다리며 대기한 시간
+	IRQ     uint64 // 하드웨어 인터럽트 처리에 사용한 시간
+	SoftIRQ uint64 // 소프트웨어 인터럽트 처리에 사용한 시간
+	Steal   uint64 // 가상화 환경에서 하이퍼바이저가 다른 VM에 할당하여 빼앗긴 시간
 }
 
 // MemStat 메모리 상태 정보 구조체
@@ -59,6 +62,10 @@ type NetworkTraffic struct {
 	Interface   string  // 인터페이스명
 	RxBytes     uint64  // 수신 바이트 (Inbound)
 	TxBytes     uint64  // 송신 바이트 (Outbound)
+	RxErrors    uint64  // 수신 에러 수
+	RxDropped   uint64  // 수신 드롭 패킷 수
+	TxErrors    uint64  // 송신 에러 수
+	TxDropped   uint64  // 송신 드롭 패킷 수
 	InboundBps  float64 // 인바운드 트래픽량 (bps)
 	OutboundBps float64 // 아웃바운드 트래픽량 (bps)
 }
@@ -81,27 +88,89 @@ func GetCPUStat() (CPUStat, error) {
 		// 공백을 기준으로 각 필드 파싱
 		fields := strings.Fields(line)
 		if len(fields) >= 6 && fields[0] == "cpu" {
-			// 각 필드 값 획득
-			user, _ := strconv.ParseUint(fields[1], 10, 64)
-			nice, _ := strconv.ParseUint(fields[2], 10, 64)
-			system, _ := strconv.ParseUint(fields[3], 10, 64)
-			idle, _ := strconv.ParseUint(fields[4], 10, 64)
-			iowait, _ := strconv.ParseUint(fields[5], 10, 64)
-
 			// CPU 상태 정보 반환
-			return CPUStat{
-				User:   user,
-				Nice:   nice,
-				System: system,
-				Idle:   idle,
-				IOWait: iowait,
-			}, nil
+			return parseCPUFields(fields), nil
 		}
 	}
 
 	return CPUStat{}, fmt.Errorf("CPU stats not found")
 }
 
+// GetPerCPUStat 코어 별 CPU 상태 정보 획득
+//
+// 반환되는 리스트의 인덱스 N은 /proc/stat의 cpuN 라인에 해당하며,
+// 오프라인 코어 등으로 라인이 없는 코어는 0으로 채워짐
+//
+// Returns:
+//   - []CPUStat: 코어 별 CPU 상태 정보 리스트
+//   - error: 성공(nil), 실패(error)
+func GetPerCPUStat() ([]CPUStat, error) {
+	// CPU 상태 정보 파일 읽기
+	data, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return nil, err
+	}
+
+	var cpuStats []CPUStat
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 6 || !strings.HasPrefix(fields[0], "cpu") || fields[0] == "cpu" {
+			continue
+		}
+
+		// 코어 번호 추출 (ex: cpu12 -> 12)
+		core, err := strconv.Atoi(strings.TrimPrefix(fields[0], "cpu"))
+		if err != nil || core < 0 {
+			continue
+		}
+
+		// 코어 번호를 인덱스로 사용
+		if core >= len(cpuStats) {
+			cpuStats = append(cpuStats, make([]CPUStat, core+1-len(cpuStats))...)
+		}
+		cpuStats[core] = parseCPUFields(fields)
+	}
+
+	if len(cpuStats) == 0 {
+		return nil, fmt.Errorf("per-CPU stats not found")
+	}
+
+	return cpuStats, nil
+}
+
+// parseCPUFields /proc/stat의 cpu 라인 필드 파싱
+//
+// Parameters:
+//   - fields: 공백으로 분리된 cpu 라인 필드 (최소 6개)
+//
+// Returns:
+//   - CPUStat: CPU 상태 정보 구조체
+func parseCPUFields(fields []string) CPUStat {
+	// 각 필드 값 획득
+	user, _ := strconv.ParseUint(fields[1], 10, 64)
+	nice, _ := strconv.ParseUint(fields[2], 10, 64)
+	system, _ := strconv.ParseUint(fields[3], 10, 64)
+	idle, _ := strconv.ParseUint(fields[4], 10, 64)
+	iowait, _ := strconv.ParseUint(fields[5], 10, 64)
+
+	cpuStat := CPUStat{
+		User:   user,
+		Nice:   nice,
+		System: system,
+		Idle:   idle,
+		IOWait: iowait,
+	}
+
+	// irq, softirq, steal 필드는 커널 버전에 따라 없을 수 있음
+	if len(fields) >= 9 {
+		cpuStat.IRQ, _ = strconv.ParseUint(fields[6], 10, 64)
+		cpuStat.SoftIRQ, _ = strconv.ParseUint(fields[7], 10, 64)
+		cpuStat.Steal, _ = strconv.ParseUint(fields[8], 10, 64)
+	}
+
+	return cpuStat
+}
+
 // CalculateCPURate CPU 사용률 계산
 //
 // Parameters:
@@ -124,6 +193,63 @@ func CalculateCPURate(prev, current CPUStat) float64 {
 	return (float64(totalDiff-idleDiff) / float64(totalDiff)) * 100
 }
 
+// CalculatePerCPURate 코어 별 CPU 사용률 계산
+//
+// 두 리스트의 길이가 다르면(CPU 핫플러그 등) 짧은 쪽 기준으로 계산
+//
+// Parameters:
+//   - prev: 이전 코어 별 CPU 상태 정보 리스트
+//   - current: 현재 코어 별 CPU 상태 정보 리스트
+//
+// Returns:
+//   - []float64: 코어 별 CPU 사용률 (인덱스는 코어 번호)
+func CalculatePerCPURate(prev, current []CPUStat) []float64 {
+	n := min(len(prev), len(current))
+	rates := make([]float64, n)
+	for i := 0; i < n; i++ {
+		rates[i] = CalculateCPURate(prev[i], current[i])
+	}
+	return rates
+}
+
+// CalculateCPUModeRates CPU 모드 별 사용률 계산
+//
+// Parameters:
+//   - prev: 이전 CPU 상태 정보
+//   - current: 현재 CPU 상태 정보
+//
+// Returns:
+//   - map[string]float64: 모드 별 CPU 사용률
+//     (user, nice, system, idle, iowait, irq, softirq, steal)
+func CalculateCPUModeRates(prev, current CPUStat) map[string]float64 {
+	diffs := map[string]uint64{
+		"user":    current.User - prev.User,
+		"nice":    current.Nice - prev.Nice,
+		"system":  current.System - prev.System,
+		"idle":    current.Idle - prev.Idle,
+		"iowait":  current.IOWait - prev.IOWait,
+		"irq":     current.IRQ - prev.IRQ,
+		"softirq": current.SoftIRQ - prev.SoftIRQ,
+		"steal":   current.Steal - prev.Steal,
+	}
+
+	var totalDiff uint64
+	for _, diff := range diffs {
+		totalDiff += diff
+	}
+
+	rates := make(map[string]float64, len(diffs))
+	for mode, diff := range diffs {
+		if totalDiff == 0 {
+			rates[mode] = 0.0
+			continue
+		}
+		rates[mode] = (float64(diff) / float64(totalDiff)) * 100
+	}
+
+	return rates
+}
+
 // GetMemStat 메모리 상태 정보 획득
 //
 // Returns:
@@ -191,6 +317,21 @@ func CalculateMemRate(memStat MemStat) float64 {
 	return (float64(used) / float64(memStat.MemTotal)) * 100
 }
 
+// CalculateSwapRate 스왑 메모리 사용률 계산
+//
+// Parameters:
+//   - memStat: 메모리 상태 정보 구조체
+//
+// Returns:
+//   - float64: 스왑 메모리 사용률 (스왑이 없으면 0)
+func CalculateSwapRate(memStat MemStat) float64 {
+	if memStat.SwapTotal == 0 {
+		return 0.0
+	}
+	used := memStat.SwapTotal - memStat.SwapFree
+	return (float64(used) / float64(memStat.SwapTotal)) * 100
+}
+
 // GetDiskStat 지정된 경로의 디스크 상태 정보 획득
 //
 // Parameters:
@@ -239,17 +380,32 @@ func CalculateDiskRate(diskStat DiskStat) float64 {
 
 // GetAllNetworkTraffic 모든 인터페이스에 대한 Rx, Tx 정보 획득
 //
+// Parameters:
+//   - excludePrefixes: 제외할 인터페이스명 접두사 리스트 (지정하지 않으면 lo 인터페이스만 제외)
+//
 // Returns:
 //   - []NetworkTraffic: 네트워크 트래픽 리스트
 //   - error: 성공(nil), 실패(error)
-func GetAllNetworkTraffic() ([]NetworkTraffic, error) {
+func GetAllNetworkTraffic(excludePrefixes ...string) ([]NetworkTraffic, error) {
 	// 네트워크 트래픽 상태 정보 파일 읽기
 	data, err := os.ReadFile("/proc/net/dev")
 	if err != nil {
 		return nil, err
 	}
 
-	lines := strings.Split(string(data), "\n")
+	return parseNetDev(string(data), excludePrefixes), nil
+}
+
+// parseNetDev /proc/net/dev 내용 파싱
+//
+// Parameters:
+//   - data: /proc/net/dev 파일 내용
+//   - excludePrefixes: 제외할 인터페이스명 접두사 리스트 (비어 있으면 lo 인터페이스만 제외)
+//
+// Returns:
+//   - []NetworkTraffic: 네트워크 트래픽 리스트
+func parseNetDev(data string, excludePrefixes []string) []NetworkTraffic {
+	lines := strings.Split(data, "\n")
 	var trafficList []NetworkTraffic
 
 	for _, line := range lines {
@@ -260,8 +416,8 @@ func GetAllNetworkTraffic() ([]NetworkTraffic, error) {
 
 		// 인터페이스명 추출
 		interfaceName := strings.TrimSuffix(fields[0], ":")
-		// lo 인터페이스는 무시
-		if interfaceName == "lo" {
+		// 제외 대상 인터페이스는 무시
+		if isExcludedInterface(interfaceName, excludePrefixes) {
 			continue
 		}
 		// 수신 바이트 획득
@@ -275,15 +431,46 @@ func GetAllNetworkTraffic() ([]NetworkTraffic, error) {
 			continue
 		}
 
-		// 리스트에 추가
-		trafficList = append(trafficList, NetworkTraffic{
+		traffic := NetworkTraffic{
 			Interface: interfaceName,
 			RxBytes:   rxBytes,
 			TxBytes:   txBytes,
-		})
+		}
+
+		// 에러 및 드롭 카운터 획득 (rx: errs, drop / tx: errs, drop)
+		if len(fields) >= 13 {
+			traffic.RxErrors, _ = strconv.ParseUint(fields[3], 10, 64)
+			traffic.RxDropped, _ = strconv.ParseUint(fields[4], 10, 64)
+			traffic.TxErrors, _ = strconv.ParseUint(fields[11], 10, 64)
+			traffic.TxDropped, _ = strconv.ParseUint(fields[12], 10, 64)
+		}
+
+		// 리스트에 추가
+		trafficList = append(trafficList, traffic)
 	}
 
-	return trafficList, nil
+	return trafficList
+}
+
+// isExcludedInterface 인터페이스가 제외 대상인지 확인
+//
+// Parameters:
+//   - name: 인터페이스명
+//   - excludePrefixes: 제외할 인터페이스명 접두사 리스트 (비어 있으면 lo 인터페이스만 제외)
+//
+// Returns:
+//   - bool: 제외 대상(true), 수집 대상(false)
+func isExcludedInterface(name string, excludePrefixes []string) bool {
+	if len(excludePrefixes) == 0 {
+		return name == "lo"
+	}
+
+	for _, prefix := range excludePrefixes {
+		if prefix != "" && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 // CalculateNetworkTraffic 인터페이스 별 네트워크 트래픽량 계산 (bps)
@@ -291,7 +478,7 @@ func GetAllNetworkTraffic() ([]NetworkTraffic, error) {
 // Parameters:
 //   - prev: 이전 네트워크 트래픽 상태 정보 리스트
 //   - current: 현재 네트워크 트래픽 상태 정보 리스트
-//   - intervalSec: bps 측정 간격 시간 (초)
+//   - intervalSec: bps 측정 간격 시간 (초, 단조 시계 기준으로 측정된 값)
 //
 // Returns:
 //   - []NetworkTraffic: 네트워크 트래픽량 리스트
@@ -299,28 +486,52 @@ func GetAllNetworkTraffic() ([]NetworkTraffic, error) {
 func CalculateNetworkTraffic(prev, current []NetworkTraffic, intervalSec float64) ([]NetworkTraffic, error) {
 	var trafficList []NetworkTraffic
 
-	if intervalSec == 0.0 {
-		return nil, fmt.Errorf("interval seconds is zero")
+	// 벽시계 시간 차이로 계산된 간격은 시간 변경(NTP step 등) 시 0 또는 음수가 될 수 있음
+	if intervalSec <= 0.0 {
+		return nil, fmt.Errorf("invalid interval seconds (%f)", intervalSec)
 	}
 
-	for _, t1 := range prev {
-		for _, t2 := range current {
-			if t1.Interface != t2.Interface {
-				continue
-			}
-			inboundBytes := t2.RxBytes - t1.RxBytes
-			outboundBytes := t2.TxBytes - t1.TxBytes
-
-			// bps 계산 (bytes -> Bits로 변환)
-			inboundBps := float64(inboundBytes*8) / intervalSec
-			outboundBps := float64(outboundBytes*8) / intervalSec
-
-			trafficList = append(trafficList, NetworkTraffic{
-				Interface:   t2.Interface,
-				InboundBps:  inboundBps,
-				OutboundBps: outboundBps,
-			})
+	// 네트워크 네임스페이스 등으로 같은 인터페이스명이 중복될 수 있으므로
+	// 인터페이스명 기준으로 중복을 제거 (중복 시 마지막 항목 사용)
+	prevByName := make(map[string]NetworkTraffic, len(prev))
+	for _, t := range prev {
+		prevByName[t.Interface] = t
+	}
+	currentByName := make(map[string]NetworkTraffic, len(current))
+	var names []string
+	for _, t := range current {
+		if _, exists := currentByName[t.Interface]; !exists {
+			names = append(names, t.Interface)
 		}
+		currentByName[t.Interface] = t
+	}
+
+	for _, name := range names {
+		t1, exists := prevByName[name]
+		if !exists {
+			continue
+		}
+		t2 := currentByName[name]
+
+		inboundBytes := t2.RxBytes - t1.RxBytes
+		outboundBytes := t2.TxBytes - t1.TxBytes
+
+		// bps 계산 (bytes -> Bits로 변환)
+		inboundBps := float64(inboundBytes*8) / intervalSec
+		outboundBps := float64(outboundBytes*8) / intervalSec
+
+		// 누적 카운터는 현재 값을 그대로 전달
+		trafficList = append(trafficList, NetworkTraffic{
+			Interface:   t2.Interface,
+			RxBytes:     t2.RxBytes,
+			TxBytes:     t2.TxBytes,
+			RxErrors:    t2.RxErrors,
+			RxDropped:   t2.RxDropped,
+			TxErrors:    t2.TxErrors,
+			TxDropped:   t2.TxDropped,
+			InboundBps:  inboundBps,
+			OutboundBps: outboundBps,
+		})
 	}
 
 	if len(trafficList) == 0 {
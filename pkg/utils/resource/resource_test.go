@@ -0,0 +1,112 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestCalculateNetworkTrafficDuplicateNames 인터페이스명이 중복되어도 인터페이스별로 하나의 결과만
+// 반환하고, 중복 시 마지막 항목을 사용하는지 확인
+func TestCalculateNetworkTrafficDuplicateNames(t *testing.T) {
+	prev := []NetworkTraffic{
+		{Interface: "eth0", RxBytes: 0, TxBytes: 0},
+		{Interface: "eth1", RxBytes: 100, TxBytes: 100},
+		{Interface: "eth0", RxBytes: 1000, TxBytes: 2000},
+	}
+	current := []NetworkTraffic{
+		{Interface: "eth0", RxBytes: 500, TxBytes: 500},
+		{Interface: "eth1", RxBytes: 200, TxBytes: 300},
+		{Interface: "eth0", RxBytes: 2000, TxBytes: 4000},
+	}
+
+	got, err := CalculateNetworkTraffic(prev, current, 1)
+	if err != nil {
+		t.Fatalf("CalculateNetworkTraffic failed: %v", err)
+	}
+
+	// 인터페이스별 기대 bps (마지막 항목 기준: (현재 - 이전) * 8)
+	want := map[string][2]float64{
+		"eth0": {8000, 16000},
+		"eth1": {800, 1600},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(got), len(want), got)
+	}
+
+	seen := make(map[string]bool)
+	for _, traffic := range got {
+		if seen[traffic.Interface] {
+			t.Fatalf("duplicate entry for interface %s", traffic.Interface)
+		}
+		seen[traffic.Interface] = true
+
+		bps, ok := want[traffic.Interface]
+		if !ok {
+			t.Fatalf("unexpected interface %s", traffic.Interface)
+		}
+		if traffic.InboundBps != bps[0] || traffic.OutboundBps != bps[1] {
+			t.Errorf("%s: got (in %v, out %v) bps, want (in %v, out %v)",
+				traffic.Interface, traffic.InboundBps, traffic.OutboundBps, bps[0], bps[1])
+		}
+	}
+}
+
+// netDevFixture 가상 인터페이스가 섞인 /proc/net/dev 내용
+const netDevFixture = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo: 1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0: 2000      20    1    2    0     0          0         0     3000      30    3    4    0     0       0          0
+docker0: 4000     40    0    0    0     0          0         0     5000      50    0    0    0     0       0          0
+vethab12: 6000    60    0    0    0     0          0         0     7000      70    0    0    0     0       0          0
+br-1a2b3c: 8000   80    0    0    0     0          0         0     9000      90    0    0    0     0       0          0
+`
+
+// TestParseNetDev 제외 접두사 지정 여부에 따라 인터페이스가 제외되는지 확인
+func TestParseNetDev(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes []string
+		want     []string
+	}{
+		{"default excludes lo only", nil, []string{"eth0", "docker0", "vethab12", "br-1a2b3c"}},
+		{"explicit prefixes", []string{"lo", "docker0", "veth", "br-"}, []string{"eth0"}},
+		{"explicit prefixes keep lo", []string{"veth"}, []string{"lo", "eth0", "docker0", "br-1a2b3c"}},
+		{"empty prefix ignored", []string{""}, []string{"lo", "eth0", "docker0", "vethab12", "br-1a2b3c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, traffic := range parseNetDev(netDevFixture, tt.prefixes) {
+				got = append(got, traffic.Interface)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("interfaces = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 카운터 파싱 확인
+	traffic := parseNetDev(netDevFixture, nil)[0]
+	want := NetworkTraffic{Interface: "eth0", RxBytes: 2000, TxBytes: 3000,
+		RxErrors: 1, RxDropped: 2, TxErrors: 3, TxDropped: 4}
+	if traffic != want {
+		t.Fatalf("eth0 = %+v, want %+v", traffic, want)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/meloncoffee/weblin/pkg/utils/goroutine"
+)
+
+// Sampler 리소스 사용률을 주기적으로 계산하여 갱신하는 구조체
+//
+// GoroutineManager 작업으로 Run을 등록하여 사용하며,
+// 계산된 사용률은 SetUsage로 갱신되어 GetUsage로 조회 가능
+type Sampler struct {
+	Interval  time.Duration   // 샘플링 주기 (Run 호출 이후에는 SetInterval로 변경)
+	Collector *UsageCollector // 리소스 사용률 계산 구조체
+	OnError   func(err error) // 리소스 획득 실패 시 호출되는 함수 (nil이면 무시)
+	OnSample  func(u Usage)   // 사용률 갱신 시 호출되는 함수 (nil이면 무시, 샘플링을 지연시키지 않도록 즉시 반환해야 함)
+	// /proc 소스 사용 가능 여부가 바뀌었을 때 호출되는 함수 (nil이면 무시)
+	// 시작 시에는 사용할 수 없는 소스에 대해서만 호출되며, err가 nil이면 다시 사용 가능해진 것
+	OnSourceChange func(source string, err error)
+
+	intervalCh  chan time.Duration // 동작 중 샘플링 주기 변경 요청
+	interval    atomic.Int64       // 현재 적용 중인 샘플링 주기 (다른 고루틴 조회용)
+	recheckCh   chan struct{}      // 동작 중 /proc 소스 재확인 요청
+	unavailable map[string]bool    // 마지막 확인 시 사용할 수 없었던 /proc 소스
+	sampled     atomic.Bool        // 구간 사용률 계산 성공 여부 (CPU, 메모리 기준)
+	lastSample  atomic.Int64       // 마지막 구간 사용률 계산 성공 시각 (Unix nano, CPU, 메모리 기준)
+}
+
+// NewSampler 리소스 사용률 샘플러 생성
+//
+// Parameters:
+//   - interval: 샘플링 주기
+//   - collector: 리소스 사용률 계산 구조체
+//
+// Returns:
+//   - *Sampler
+func NewSampler(interval time.Duration, collector *UsageCollector) *Sampler {
+	return &Sampler{
+		Interval:   interval,
+		Collector:  collector,
+		intervalCh: make(chan time.Duration, 1),
+		recheckCh:  make(chan struct{}, 1),
+	}
+}
+
+// Run 컨텍스트가 종료될 때까지 주기적으로 리소스 사용률 계산 및 갱신
+//
+// 시작 시 기준 스냅샷을 먼저 측정하므로 첫 주기 이후부터 구간 사용률이 갱신됨
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+func (s *Sampler) Run(ctx context.Context) {
+	// 읽을 수 없는 /proc 소스는 매 주기 에러가 반복되지 않도록 수집에서 제외
+	s.checkSources(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	// 기준 스냅샷 측정 (부팅 이후 평균값이므로 갱신하지 않음)
+	if _, err := s.Collector.CollectContext(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		s.handleError(err)
+	}
+	// 기준 스냅샷 측정이 끝났으므로 후행 작업 가동 허용
+	goroutine.MarkReady(ctx)
+
+	// 패닉 후 재시작된 경우에도 변경된 샘플링 주기 유지
+	interval := s.CurrentInterval()
+	s.interval.Store(int64(interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case interval := <-s.intervalCh:
+			s.interval.Store(int64(interval))
+			ticker.Reset(interval)
+		case <-s.recheckCh:
+			s.checkSources(ctx)
+		case <-ticker.C:
+			// 종료 요청 시 멈춘 리소스 획득을 기다리지 않고 종료
+			usage, err := s.Collector.CollectContext(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				s.handleError(err)
+			}
+			SetUsage(usage)
+			// 디스크/네트워크 획득 실패는 경고로 보고 CPU, 메모리 사용률이 계산되었으면 성공으로 기록
+			var warning *CollectWarning
+			if err == nil || errors.As(err, &warning) {
+				s.sampled.Store(true)
+				s.lastSample.Store(time.Now().UnixNano())
+			}
+			if s.OnSample != nil {
+				s.OnSample(usage)
+			}
+		}
+	}
+}
+
+// Sampled 구간 사용률 계산에 한번 이상 성공했는지 확인
+//
+// Returns:
+//   - bool: 성공한 적 있음(true), 없음(false)
+func (s *Sampler) Sampled() bool {
+	return s.sampled.Load()
+}
+
+// LastSample 마지막으로 구간 사용률 계산에 성공한 시각 반환
+//
+// Returns:
+//   - time.Time: 마지막 성공 시각 (성공한 적 없으면 zero time)
+func (s *Sampler) LastSample() time.Time {
+	nano := s.lastSample.Load()
+	if nano == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nano)
+}
+
+// CurrentInterval 현재 적용 중인 샘플링 주기 반환
+//
+// Returns:
+//   - time.Duration: 샘플링 주기 (Run 호출 이전이면 Interval)
+func (s *Sampler) CurrentInterval() time.Duration {
+	if interval := s.interval.Load(); interval > 0 {
+		return time.Duration(interval)
+	}
+	return s.Interval
+}
+
+// SetInterval 동작 중인 샘플러의 샘플링 주기 변경
+//
+// 아직 처리되지 않은 변경 요청이 있으면 새 요청으로 대체
+//
+// Parameters:
+//   - interval: 샘플링 주기
+func (s *Sampler) SetInterval(interval time.Duration) {
+	for {
+		select {
+		case s.intervalCh <- interval:
+			return
+		default:
+			// 처리되지 않은 이전 요청 제거 후 재시도
+			select {
+			case <-s.intervalCh:
+			default:
+			}
+		}
+	}
+}
+
+// Recheck 동작 중인 샘플러의 /proc 소스 사용 가능 여부 재확인 요청
+//
+// 다음 샘플링 전에 샘플러 고루틴에서 확인하며, 처리되지 않은 요청이 있으면 무시
+func (s *Sampler) Recheck() {
+	select {
+	case s.recheckCh <- struct{}{}:
+	default:
+	}
+}
+
+// checkSources /proc 소스 사용 가능 여부를 확인하고 변경된 소스를 OnSourceChange로 알림
+//
+// Parameters:
+//   - ctx: 작업 종료 컨텍스트
+func (s *Sampler) checkSources(ctx context.Context) {
+	results := s.Collector.CheckSources(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	// 알림 순서를 고정하기 위해 소스명 순으로 처리
+	sources := make([]string, 0, len(results))
+	for source := range results {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	unavailable := make(map[string]bool, len(results))
+	for _, source := range sources {
+		err := results[source]
+		if err != nil {
+			unavailable[source] = true
+		}
+		if (err != nil) != s.unavailable[source] && s.OnSourceChange != nil {
+			s.OnSourceChange(source, err)
+		}
+	}
+	s.unavailable = unavailable
+}
+
+// handleError 리소스 획득 실패 처리
+//
+// Parameters:
+//   - err: 에러
+func (s *Sampler) handleError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestSamplerDiskPathFails 설정된 디스크 경로 하나를 읽을 수 없어도 샘플링 성공으로 기록되는지 확인
+//
+// 마운트되지 않은 경로 때문에 준비 상태(/ready)가 계속 실패하거나 헬스 체크에서
+// 샘플러가 지연된 것으로 보고되지 않아야 함
+func TestSamplerDiskPathFails(t *testing.T) {
+	collector := &UsageCollector{
+		DiskPaths:   []string{"/", filepath.Join(t.TempDir(), "unmounted")},
+		readNetwork: fakeNetwork([2]uint64{1000, 1000}, [2]uint64{2000, 2000}),
+	}
+	sampler := NewSampler(10*time.Millisecond, collector)
+	errCh := make(chan error, 16)
+	sampler.OnError = func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sampler.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !sampler.Sampled() {
+		if time.Now().After(deadline) {
+			t.Fatal("sampler never recorded a successful sample")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if sampler.LastSample().IsZero() {
+		t.Fatal("LastSample is zero after a successful sample")
+	}
+
+	// 실패한 디스크 경로는 OnError로 계속 보고됨
+	select {
+	case <-errCh:
+	default:
+		t.Fatal("disk path failure was not reported")
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// SNMPStats 프로토콜 계층 카운터 정보 구조체 (/proc/net/snmp)
+type SNMPStats struct {
+	TCPActiveOpens  uint64 // 능동적으로 연결을 시작한 TCP 연결 수
+	TCPPassiveOpens uint64 // 수동적으로 수락한 TCP 연결 수
+	TCPInSegs       uint64 // 수신한 TCP 세그먼트 수
+	TCPOutSegs      uint64 // 송신한 TCP 세그먼트 수
+	TCPRetransSegs  uint64 // 재전송한 TCP 세그먼트 수
+	TCPInErrs       uint64 // 에러가 발생한 수신 TCP 세그먼트 수
+	UDPInDatagrams  uint64 // 수신한 UDP 데이터그램 수
+	UDPOutDatagrams uint64 // 송신한 UDP 데이터그램 수
+	UDPInErrors     uint64 // 에러가 발생한 수신 UDP 데이터그램 수
+	UDPNoPorts      uint64 // 수신 포트가 없는 UDP 데이터그램 수
+	UDPRcvbufErrors uint64 // 수신 버퍼 부족으로 버려진 UDP 데이터그램 수
+	UDPSndbufErrors uint64 // 송신 버퍼 부족으로 버려진 UDP 데이터그램 수
+}
+
+// GetSNMPStats 프로토콜 계층 카운터 정보 획득
+//
+// Returns:
+//   - SNMPStats: 프로토콜 계층 카운터 정보 구조체
+//   - error: 성공(nil), 실패(error)
+func GetSNMPStats() (SNMPStats, error) {
+	// SNMP 카운터 정보 파일 읽기
+	data, err := os.ReadFile("/proc/net/snmp")
+	if err != nil {
+		return SNMPStats{}, err
+	}
+
+	counters, err := parseSNMP(string(data))
+	if err != nil {
+		return SNMPStats{}, err
+	}
+
+	tcp, ok := counters["Tcp"]
+	if !ok {
+		return SNMPStats{}, fmt.Errorf("TCP stats not found")
+	}
+	udp, ok := counters["Udp"]
+	if !ok {
+		return SNMPStats{}, fmt.Errorf("UDP stats not found")
+	}
+
+	return SNMPStats{
+		TCPActiveOpens:  tcp["ActiveOpens"],
+		TCPPassiveOpens: tcp["PassiveOpens"],
+		TCPInSegs:       tcp["InSegs"],
+		TCPOutSegs:      tcp["OutSegs"],
+		TCPRetransSegs:  tcp["RetransSegs"],
+		TCPInErrs:       tcp["InErrs"],
+		UDPInDatagrams:  udp["InDatagrams"],
+		UDPOutDatagrams: udp["OutDatagrams"],
+		UDPInErrors:     udp["InErrors"],
+		UDPNoPorts:      udp["NoPorts"],
+		UDPRcvbufErrors: udp["RcvbufErrors"],
+		UDPSndbufErrors: udp["SndbufErrors"],
+	}, nil
+}
+
+// parseSNMP /proc/net/snmp 형식의 데이터 파싱
+//
+// 각 프로토콜은 필드명 라인(헤더)과 값 라인이 한 쌍으로 구성됨
+//
+//	Tcp: RtoAlgorithm RtoMin ... RetransSegs ...
+//	Tcp: 1 200 ... 42 ...
+//
+// Parameters:
+//   - data: /proc/net/snmp 파일 내용
+//
+// Returns:
+//   - map[string]map[string]uint64: 프로토콜 별 필드명-값 맵
+//   - error: 성공(nil), 실패(error)
+func parseSNMP(data string) (map[string]map[string]uint64, error) {
+	counters := make(map[string]map[string]uint64)
+
+	lines := strings.Split(strings.TrimSpace(data), "\n")
+	if len(lines)%2 != 0 {
+		return nil, fmt.Errorf("unexpected snmp format (lines: %d)", len(lines))
+	}
+
+	for i := 0; i < len(lines); i += 2 {
+		header := strings.Fields(lines[i])
+		values := strings.Fields(lines[i+1])
+
+		if len(header) == 0 || len(header) != len(values) || header[0] != values[0] {
+			return nil, fmt.Errorf("mismatched snmp header/value line (line: %d)", i+1)
+		}
+
+		proto := strings.TrimSuffix(header[0], ":")
+		fields := make(map[string]uint64, len(header)-1)
+		for j := 1; j < len(header); j++ {
+			// MaxConn과 같이 음수(-1)를 갖는 필드는 무시
+			value, err := strconv.ParseUint(values[j], 10, 64)
+			if err != nil {
+				continue
+			}
+			fields[header[j]] = value
+		}
+		counters[proto] = fields
+	}
+
+	return counters, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// SoftirqStat CPU 별 softirq 카운트 정보 구조체 (/proc/softirqs)
+type SoftirqStat struct {
+	CPUs   []string            // CPU명 리스트 (cpu0, cpu1, ...)
+	Counts map[string][]uint64 // softirq 타입 별 CPU 당 카운트 (CPUs와 인덱스 일치)
+}
+
+// GetSoftirqStat CPU 별 softirq 카운트 정보 획득
+//
+// Returns:
+//   - SoftirqStat: softirq 카운트 정보 구조체
+//   - error: 성공(nil), 실패(error)
+func GetSoftirqStat() (SoftirqStat, error) {
+	// softirq 정보 파일 읽기
+	data, err := os.ReadFile("/proc/softirqs")
+	if err != nil {
+		return SoftirqStat{}, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	if len(lines) == 0 {
+		return SoftirqStat{}, fmt.Errorf("softirq stats not found")
+	}
+
+	// 첫 번째 라인(헤더)에서 CPU명 파싱
+	header := strings.Fields(lines[0])
+	if len(header) == 0 {
+		return SoftirqStat{}, fmt.Errorf("softirq header not found")
+	}
+	cpus := make([]string, len(header))
+	for i, name := range header {
+		cpus[i] = strings.ToLower(name)
+	}
+
+	stat := SoftirqStat{
+		CPUs:   cpus,
+		Counts: make(map[string][]uint64),
+	}
+
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		// softirq 타입명 추출 (ex: "NET_RX:")
+		irqType := strings.TrimSuffix(fields[0], ":")
+
+		// CPU 수와 컬럼 수가 다를 수 있으므로 작은 쪽을 기준으로 파싱
+		counts := make([]uint64, len(cpus))
+		for i := 0; i < len(cpus) && i+1 < len(fields); i++ {
+			count, err := strconv.ParseUint(fields[i+1], 10, 64)
+			if err != nil {
+				continue
+			}
+			counts[i] = count
+		}
+		stat.Counts[irqType] = counts
+	}
+
+	return stat, nil
+}
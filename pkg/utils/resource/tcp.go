@@ -0,0 +1,102 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// TCP 연결 상태 코드와 상태명 매핑 (include/net/tcp_states.h)
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+	"0C": "NEW_SYN_RECV",
+}
+
+// GetTCPStats TCP 연결 상태 별 소켓 수 획득
+//
+// /proc/net/tcp와 /proc/net/tcp6를 합산하며, 연결이 없는 상태도 0으로 포함.
+// IPv6가 비활성화되어 /proc/net/tcp6가 없는 환경에서는 /proc/net/tcp만 사용.
+//
+// Returns:
+//   - map[string]int: 상태명 별 소켓 수 (ex: ESTABLISHED, TIME_WAIT)
+//   - error: 성공(nil), 실패(error)
+func GetTCPStats() (map[string]int, error) {
+	counts := make(map[string]int, len(tcpStates))
+	for _, state := range tcpStates {
+		counts[state] = 0
+	}
+
+	if err := countTCPStates("/proc/net/tcp", counts); err != nil {
+		return nil, err
+	}
+	if err := countTCPStates("/proc/net/tcp6", counts); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// countTCPStates 소켓 정보 파일을 읽어 연결 상태 별 소켓 수 합산
+//
+// 연결이 많은 서버에서는 파일이 클 수 있으므로 한 줄씩 읽어서 처리
+//
+// Parameters:
+//   - path: 소켓 정보 파일 경로 (/proc/net/tcp, /proc/net/tcp6)
+//   - counts: 상태명 별 소켓 수를 합산할 맵
+//
+// Returns:
+//   - error: 성공(nil), 실패(error)
+func countTCPStates(path string, counts map[string]int) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	// 첫 줄은 헤더 (sl local_address rem_address st ...)
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		if state, ok := tcpStates[strings.ToUpper(fields[3])]; ok {
+			counts[state]++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// TempReading 온도 센서 측정값 정보 구조체
+type TempReading struct {
+	Label   string  // 센서명 (ex: coretemp/Core 0)
+	Celsius float64 // 온도 (섭씨)
+}
+
+// ThrottleCount CPU 코어 별 열 스로틀링 발생 횟수 정보 구조체
+type ThrottleCount struct {
+	Core  string // 코어 번호
+	Count uint64 // 스로틀링 발생 횟수
+}
+
+// GetCPUThrottleCounts CPU 코어 별 열 스로틀링 발생 횟수 획득
+//
+// 가상 머신 등 thermal_throttle 정보가 없는 환경에서는 빈 리스트를 반환
+//
+// Returns:
+//   - []ThrottleCount: 코어 별 스로틀링 발생 횟수 리스트
+//   - error: 성공(nil), 실패(error)
+func GetCPUThrottleCounts() ([]ThrottleCount, error) {
+	paths, err := filepath.Glob("/sys/devices/system/cpu/cpu[0-9]*/thermal_throttle/core_throttle_count")
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]ThrottleCount, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		count, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// 경로에서 코어 번호 추출 (ex: .../cpu3/thermal_throttle/... -> 3)
+		cpuDir := filepath.Base(filepath.Dir(filepath.Dir(path)))
+		counts = append(counts, ThrottleCount{
+			Core:  strings.TrimPrefix(cpuDir, "cpu"),
+			Count: count,
+		})
+	}
+
+	return counts, nil
+}
+
+// GetCPUTemperature hwmon 온도 센서 측정값 획득
+//
+// /sys/class/hwmon/hwmon*/temp*_input(밀리 섭씨) 값을 읽으며, 센서명은 "칩명/라벨" 형식으로 구성.
+// temp*_label이 없으면 라벨 대신 센서 파일명(ex: temp1)을 사용하고,
+// 같은 칩명이 여러 hwmon 디렉터리에 있으면 칩명에 hwmon 디렉터리명을 붙여 구분.
+// 가상 머신 등 hwmon 센서가 없는 환경에서는 빈 리스트를 반환.
+//
+// Returns:
+//   - []TempReading: 센서 별 온도 리스트
+//   - error: 성공(nil), 실패(error)
+func GetCPUTemperature() ([]TempReading, error) {
+	inputs, err := filepath.Glob("/sys/class/hwmon/hwmon*/temp*_input")
+	if err != nil {
+		return nil, err
+	}
+
+	readings := make([]TempReading, 0, len(inputs))
+	chips := make(map[string]string)    // hwmon 디렉터리 별 칩명
+	chipDirs := make(map[string]string) // 칩명 별 처음 확인된 hwmon 디렉터리
+	for _, input := range inputs {
+		data, err := os.ReadFile(input)
+		if err != nil {
+			continue
+		}
+		milli, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// 칩명 획득 (hwmon 디렉터리의 name 파일, 없으면 디렉터리명)
+		dir := filepath.Dir(input)
+		chip, ok := chips[dir]
+		if !ok {
+			chip = filepath.Base(dir)
+			if name, err := os.ReadFile(filepath.Join(dir, "name")); err == nil {
+				if trimmed := strings.TrimSpace(string(name)); trimmed != "" {
+					chip = trimmed
+				}
+			}
+			if firstDir, exists := chipDirs[chip]; exists && firstDir != dir {
+				chip += "-" + filepath.Base(dir)
+			} else {
+				chipDirs[chip] = dir
+			}
+			chips[dir] = chip
+		}
+
+		// 센서 라벨 획득 (ex: temp1_input -> temp1_label, 없으면 temp1)
+		sensor := strings.TrimSuffix(filepath.Base(input), "_input")
+		label := sensor
+		if data, err := os.ReadFile(filepath.Join(dir, sensor+"_label")); err == nil {
+			if trimmed := strings.TrimSpace(string(data)); trimmed != "" {
+				label = trimmed
+			}
+		}
+
+		readings = append(readings, TempReading{
+			Label:   chip + "/" + label,
+			Celsius: float64(milli) / 1000,
+		})
+	}
+
+	return readings, nil
+}
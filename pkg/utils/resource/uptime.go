@@ -0,0 +1,123 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetUptime 시스템 부팅 이후 경과 시간 획득
+//
+// Returns:
+//   - time.Duration: 시스템 가동 시간
+//   - error: 성공(nil), 실패(error)
+func GetUptime() (time.Duration, error) {
+	data, err := os.ReadFile("/proc/uptime")
+	if err != nil {
+		return 0, err
+	}
+
+	return parseUptime(string(data))
+}
+
+// parseUptime /proc/uptime 내용 파싱
+//
+// 형식: "350735.47 234388.90" (가동 시간, 전체 코어의 유휴 시간 합계)
+//
+// Parameters:
+//   - data: /proc/uptime 파일 내용
+//
+// Returns:
+//   - time.Duration: 시스템 가동 시간
+//   - error: 성공(nil), 실패(error)
+func parseUptime(data string) (time.Duration, error) {
+	fields := strings.Fields(data)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty uptime")
+	}
+
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid uptime %q: %v", fields[0], err)
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// 커널이 사용자 공간에 노출하는 클럭 틱 단위 (USER_HZ, 지원하는 모든 아키텍처에서 100)
+const userHZ = 100
+
+// GetProcessUptime 프로세스 시작 이후 경과 시간 획득
+//
+// Parameters:
+//   - pid: 프로세스 PID
+//
+// Returns:
+//   - time.Duration: 프로세스 가동 시간
+//   - error: 성공(nil), 실패(error)
+func GetProcessUptime(pid int) (time.Duration, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	started, err := parseProcStartTime(string(data))
+	if err != nil {
+		return 0, err
+	}
+
+	uptime, err := GetUptime()
+	if err != nil {
+		return 0, err
+	}
+
+	return max(uptime-started, 0), nil
+}
+
+// parseProcStartTime /proc/<pid>/stat 내용에서 부팅 이후 프로세스 시작 시점 파싱
+//
+// 프로세스명(comm)에 공백이나 괄호가 포함될 수 있으므로 마지막 ')' 이후 필드를 기준으로 파싱.
+// starttime은 전체 22번째 필드(')' 이후 20번째 필드)이며 클럭 틱 단위임.
+//
+// Parameters:
+//   - data: /proc/<pid>/stat 파일 내용
+//
+// Returns:
+//   - time.Duration: 부팅 이후 프로세스 시작 시점
+//   - error: 성공(nil), 실패(error)
+func parseProcStartTime(data string) (time.Duration, error) {
+	idx := strings.LastIndexByte(data, ')')
+	if idx < 0 {
+		return 0, fmt.Errorf("invalid stat format")
+	}
+
+	fields := strings.Fields(data[idx+1:])
+	if len(fields) < 20 {
+		return 0, fmt.Errorf("invalid stat format")
+	}
+
+	ticks, err := strconv.ParseUint(fields[19], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid starttime %q: %v", fields[19], err)
+	}
+
+	return time.Duration(ticks) * time.Second / userHZ, nil
+}
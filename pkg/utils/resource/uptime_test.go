@@ -0,0 +1,54 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseUptime 고정된 /proc/uptime 내용을 가동 시간으로 변환하는지 확인
+func TestParseUptime(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"350735.47 234388.90\n", 350735*time.Second + 470*time.Millisecond, false},
+		{"12.00 40.00", 12 * time.Second, false},
+		{"", 0, true},
+		{"abc 1.0", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseUptime(tt.data)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseUptime(%q) returned nil error", tt.data)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseUptime(%q) failed: %v", tt.data, err)
+			continue
+		}
+		// 부동소수점 변환 오차 허용 (1ms 미만)
+		if diff := got - tt.want; diff < -time.Millisecond || diff > time.Millisecond {
+			t.Errorf("parseUptime(%q) = %v, want %v", tt.data, got, tt.want)
+		}
+	}
+}
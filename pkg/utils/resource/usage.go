@@ -0,0 +1,295 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Usage 리소스 사용률 정보 구조체
+type Usage struct {
+	CPUUsageRate   float64            // CPU 사용률
+	CPUModeRates   map[string]float64 // CPU 모드 별 사용률
+	MemUsageRate   float64            // 메모리 사용률
+	DiskUsageRates map[string]float64 // 경로 별 디스크 사용률
+	NetworkTraffic []NetworkTraffic   // 인터페이스 별 네트워크 트래픽량
+}
+
+// 사용률 계산에 사용하는 /proc 리소스 소스명
+const (
+	SourceCPU     = "cpu"     // /proc/stat
+	SourceMemory  = "memory"  // /proc/meminfo
+	SourceNetwork = "network" // /proc/net/dev
+)
+
+// UsageCollector 이전 스냅샷과 비교하여 리소스 사용률을 계산하는 구조체
+type UsageCollector struct {
+	DiskPaths         []string // 디스크 사용률 측정 기준 경로 리스트 (비어 있으면 /)
+	ExcludeInterfaces []string // 네트워크 트래픽 측정에서 제외할 인터페이스명 접두사 리스트
+
+	mu          sync.Mutex
+	hasPrev     bool             // 이전 스냅샷 존재 여부
+	prevCPU     CPUStat          // 이전 CPU 상태 정보
+	prevNet     []NetworkTraffic // 이전 네트워크 트래픽 상태 정보
+	prevElapsed time.Duration    // 이전 스냅샷 측정 시점 (단조 시계 기준 경과 시간)
+	// 단조 시계 기준 경과 시간 획득 함수 (nil이면 monotonicElapsed, 테스트에서 교체)
+	elapsed func() time.Duration
+	// 네트워크 트래픽 획득 함수 (nil이면 GetAllNetworkTrafficContext, 테스트에서 교체)
+	readNetwork func(ctx context.Context, excludePrefixes ...string) ([]NetworkTraffic, error)
+	// 읽을 수 없어 수집을 건너뛰는 /proc 소스 (CheckSources 호출 시 갱신)
+	unavailable map[string]bool
+}
+
+// CollectWarning CPU, 메모리 사용률은 계산했으나 일부 디스크 경로 또는 네트워크 트래픽 계산에 실패했음을 나타내는 에러
+//
+// 마운트 해제된 디스크 경로나 제외 후 남은 인터페이스가 없는 경우 등은 샘플링 실패로 보지 않도록 구분
+type CollectWarning struct {
+	Err error
+}
+
+// Error 에러 메시지 반환
+//
+// Returns:
+//   - string: 에러 메시지
+func (w *CollectWarning) Error() string {
+	return w.Err.Error()
+}
+
+// Unwrap 원본 에러 반환
+//
+// Returns:
+//   - error: 원본 에러
+func (w *CollectWarning) Unwrap() error {
+	return w.Err
+}
+
+// 단조 시계 경과 시간 측정 기준 시각 (단조 시계 값 포함)
+var clockBase = time.Now()
+
+// monotonicElapsed 단조 시계(monotonic clock) 기준 경과 시간 반환
+//
+// time.Now()는 단조 시계 값을 포함하므로 time.Since()로 계산한 경과 시간은
+// 시스템 시간 변경(NTP step 등)의 영향을 받지 않음
+//
+// Returns:
+//   - time.Duration: clockBase 이후 경과 시간
+func monotonicElapsed() time.Duration {
+	return time.Since(clockBase)
+}
+
+var (
+	usageMu sync.RWMutex
+	// 가장 최근에 계산된 리소스 사용률
+	usage Usage
+)
+
+// SetUsage 리소스 사용률 정보 갱신 및 최대값 추적에 반영
+//
+// Parameters:
+//   - u: 리소스 사용률 정보
+func SetUsage(u Usage) {
+	usageMu.Lock()
+	usage = u
+	usageMu.Unlock()
+
+	// 최대값 추적에 샘플 추가
+	peaks.Add(u)
+}
+
+// GetUsage 가장 최근에 계산된 리소스 사용률 정보 획득
+//
+// Returns:
+//   - Usage: 리소스 사용률 정보
+func GetUsage() Usage {
+	usageMu.RLock()
+	defer usageMu.RUnlock()
+
+	return usage
+}
+
+// SetTargets 디스크 사용률 측정 경로와 네트워크 트래픽 제외 인터페이스 변경
+//
+// 수집 중인 다른 고루틴과 경합하지 않도록 잠금 상태에서 변경
+//
+// Parameters:
+//   - diskPaths: 디스크 사용률 측정 기준 경로 리스트
+//   - excludeInterfaces: 네트워크 트래픽 측정에서 제외할 인터페이스명 접두사 리스트
+func (u *UsageCollector) SetTargets(diskPaths, excludeInterfaces []string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.DiskPaths = diskPaths
+	u.ExcludeInterfaces = excludeInterfaces
+}
+
+// CheckSources 사용률 계산에 사용하는 /proc 소스를 읽을 수 있는지 확인
+//
+// 권한이 제한된 컨테이너 등에서 읽을 수 없거나 필요한 값이 없는 소스는 이후 Collect에서 건너뛰어,
+// 매 주기마다 같은 에러가 반복되지 않고 나머지 소스의 사용률은 계속 계산되도록 함.
+// 다시 호출하면 이전 결과를 버리고 모든 소스를 새로 확인.
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//
+// Returns:
+//   - map[string]error: 소스명 별 확인 결과 (사용 가능하면 nil)
+func (u *UsageCollector) CheckSources(ctx context.Context) map[string]error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	results := make(map[string]error, 3)
+	cpuStat, err := GetCPUStatContext(ctx)
+	results[SourceCPU] = err
+	memStat, err := GetMemStatContext(ctx)
+	if err == nil && memStat.MemTotal == 0 {
+		err = fmt.Errorf("MemTotal not found in /proc/meminfo")
+	}
+	results[SourceMemory] = err
+	_, results[SourceNetwork] = GetAllNetworkTrafficContext(ctx, u.ExcludeInterfaces...)
+
+	// 건너뛰던 소스가 다시 사용 가능해지면 오래된 스냅샷과 비교하지 않도록 이전 스냅샷 교체
+	if u.unavailable[SourceCPU] && results[SourceCPU] == nil {
+		u.prevCPU = cpuStat
+	}
+	if u.unavailable[SourceNetwork] && results[SourceNetwork] == nil {
+		u.prevNet = nil
+	}
+
+	u.unavailable = make(map[string]bool, len(results))
+	for source, err := range results {
+		if err != nil {
+			u.unavailable[source] = true
+		}
+	}
+
+	return results
+}
+
+// Collect 현재 리소스 상태 정보를 읽고 이전 스냅샷 대비 사용률 계산
+//
+// CPU 사용률과 네트워크 트래픽량은 이전 호출과의 간격을 기준으로 계산되므로,
+// 최초 호출 시 CPU 사용률은 부팅 이후 평균값이 되고 네트워크 트래픽량은 비어 있음.
+// 일부 리소스 획득에 실패해도 나머지 리소스의 사용률은 계산하여 반환.
+// CheckSources에서 사용할 수 없다고 확인된 소스는 읽지 않음.
+//
+// Returns:
+//   - Usage: 리소스 사용률 정보
+//   - error: 성공(nil), 실패(error)
+func (u *UsageCollector) Collect() (Usage, error) {
+	return u.CollectContext(context.Background())
+}
+
+// CollectContext 컨텍스트 종료 시 리소스 획득 대기를 중단하는 Collect
+//
+// 종료 중 멈춘 /proc 읽기나 디스크 statfs 호출로 샘플러 종료가 지연되지 않도록 사용하며,
+// 컨텍스트가 종료되면 남은 리소스는 획득하지 않고 컨텍스트 에러를 포함하여 반환
+//
+// Parameters:
+//   - ctx: 컨텍스트
+//
+// Returns:
+//   - Usage: 리소스 사용률 정보
+//   - error: 성공(nil), 디스크/네트워크만 실패(*CollectWarning), 실패 또는 컨텍스트 종료(error)
+func (u *UsageCollector) CollectContext(ctx context.Context) (Usage, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var usage Usage
+	// CPU, 메모리 획득 실패 (샘플링 실패)
+	var errs []error
+	// 디스크, 네트워크 획득 실패 (경고)
+	var warns []error
+	// 측정 간격은 시스템 시간 변경(NTP step 등)의 영향을 받지 않도록 단조 시계 기준으로 계산
+	elapsed := u.elapsed
+	if elapsed == nil {
+		elapsed = monotonicElapsed
+	}
+	now := elapsed()
+	readNetwork := u.readNetwork
+	if readNetwork == nil {
+		readNetwork = GetAllNetworkTrafficContext
+	}
+
+	// CPU 사용률 계산
+	if !u.unavailable[SourceCPU] {
+		cpuStat, err := GetCPUStatContext(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			usage.CPUUsageRate = CalculateCPURate(u.prevCPU, cpuStat)
+			usage.CPUModeRates = CalculateCPUModeRates(u.prevCPU, cpuStat)
+			u.prevCPU = cpuStat
+		}
+	}
+
+	// 메모리 사용률 계산
+	if !u.unavailable[SourceMemory] {
+		memStat, err := GetMemStatContext(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			usage.MemUsageRate = CalculateMemRate(memStat)
+		}
+	}
+
+	// 디스크 사용률 계산 (획득에 실패한 경로는 건너뜀)
+	diskPaths := u.DiskPaths
+	if len(diskPaths) == 0 {
+		diskPaths = []string{"/"}
+	}
+	usage.DiskUsageRates = make(map[string]float64, len(diskPaths))
+	for _, path := range diskPaths {
+		diskStat, err := GetDiskStatContext(ctx, path)
+		if err != nil {
+			warns = append(warns, fmt.Errorf("failed to get disk stat (%s): %v", path, err))
+			continue
+		}
+		usage.DiskUsageRates[path] = CalculateDiskRate(diskStat)
+	}
+
+	// 네트워크 트래픽량 계산
+	if !u.unavailable[SourceNetwork] {
+		netTraffic, err := readNetwork(ctx, u.ExcludeInterfaces...)
+		if err != nil {
+			warns = append(warns, err)
+		} else {
+			if u.hasPrev {
+				usage.NetworkTraffic, err = CalculateNetworkTraffic(u.prevNet, netTraffic,
+					(now - u.prevElapsed).Seconds())
+				if err != nil {
+					warns = append(warns, err)
+				}
+			}
+			u.prevNet = netTraffic
+		}
+	}
+
+	u.prevElapsed = now
+	u.hasPrev = true
+
+	if len(errs) > 0 {
+		return usage, errors.Join(append(errs, warns...)...)
+	}
+	if len(warns) > 0 {
+		return usage, &CollectWarning{Err: errors.Join(warns...)}
+	}
+	return usage, nil
+}
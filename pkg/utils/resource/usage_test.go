@@ -0,0 +1,146 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeNetwork 호출할 때마다 지정된 수신/송신 바이트를 차례로 반환하는 네트워크 트래픽 획득 함수 생성
+//
+// Parameters:
+//   - samples: 호출 순서별 (수신 바이트, 송신 바이트)
+//
+// Returns:
+//   - func: UsageCollector.readNetwork 대체 함수
+func fakeNetwork(samples ...[2]uint64) func(ctx context.Context, excludePrefixes ...string) ([]NetworkTraffic, error) {
+	i := 0
+	return func(ctx context.Context, excludePrefixes ...string) ([]NetworkTraffic, error) {
+		sample := samples[min(i, len(samples)-1)]
+		i++
+		return []NetworkTraffic{{Interface: "eth0", RxBytes: sample[0], TxBytes: sample[1]}}, nil
+	}
+}
+
+// fakeClock 호출할 때마다 지정된 단조 시계 경과 시간을 차례로 반환하는 함수 생성
+//
+// Parameters:
+//   - readings: 호출 순서별 경과 시간
+//
+// Returns:
+//   - func() time.Duration: UsageCollector.elapsed 대체 함수
+func fakeClock(readings ...time.Duration) func() time.Duration {
+	i := 0
+	return func() time.Duration {
+		reading := readings[min(i, len(readings)-1)]
+		i++
+		return reading
+	}
+}
+
+// TestCollectClockStep 샘플 사이에 시스템 시간이 변경(NTP step)되어도 네트워크 bps가
+// 단조 시계 기준 간격으로 계산되는지 확인
+//
+// 벽시계 시간이 한 시간 뒤로 돌아가는 동안 단조 시계는 2초 진행된 상황을 가정.
+// 벽시계 시간 차이로 계산하면 간격이 음수가 되어 bps를 계산할 수 없지만,
+// 단조 시계 기준으로는 2초 간격의 정상 bps가 계산되어야 함
+func TestCollectClockStep(t *testing.T) {
+	u := &UsageCollector{
+		elapsed:     fakeClock(10*time.Second, 12*time.Second),
+		readNetwork: fakeNetwork([2]uint64{1000, 5000}, [2]uint64{3000, 9000}),
+	}
+
+	// 기준 스냅샷
+	if _, err := u.Collect(); err != nil {
+		t.Logf("baseline collect: %v", err)
+	}
+
+	usage, err := u.Collect()
+	if err != nil {
+		t.Logf("collect: %v", err)
+	}
+	if len(usage.NetworkTraffic) != 1 {
+		t.Fatalf("got %d network entries, want 1", len(usage.NetworkTraffic))
+	}
+	traffic := usage.NetworkTraffic[0]
+	// (3000 - 1000) * 8 / 2초, (9000 - 5000) * 8 / 2초
+	if traffic.InboundBps != 8000 || traffic.OutboundBps != 16000 {
+		t.Fatalf("got (in %v, out %v) bps, want (in 8000, out 16000)", traffic.InboundBps, traffic.OutboundBps)
+	}
+}
+
+// TestCollectZeroInterval 단조 시계가 진행되지 않으면 잘못된 bps 대신 에러를 반환하는지 확인
+func TestCollectZeroInterval(t *testing.T) {
+	u := &UsageCollector{
+		elapsed:     fakeClock(10 * time.Second),
+		readNetwork: fakeNetwork([2]uint64{1000, 1000}, [2]uint64{2000, 2000}),
+	}
+
+	u.Collect()
+	usage, err := u.Collect()
+	if err == nil {
+		t.Fatal("Collect returned nil error for zero interval")
+	}
+	if len(usage.NetworkTraffic) != 0 {
+		t.Fatalf("got network entries for zero interval: %+v", usage.NetworkTraffic)
+	}
+}
+
+// TestCollectWarning 디스크 경로 하나를 읽을 수 없거나 제외 후 남은 인터페이스가 없어도
+// 샘플링 실패가 아닌 경고(*CollectWarning)로 반환하고 나머지 사용률은 계산하는지 확인
+func TestCollectWarning(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "unmounted")
+	noInterfaces := func(ctx context.Context, excludePrefixes ...string) ([]NetworkTraffic, error) {
+		return nil, nil
+	}
+
+	tests := []struct {
+		name        string
+		diskPaths   []string
+		readNetwork func(ctx context.Context, excludePrefixes ...string) ([]NetworkTraffic, error)
+	}{
+		{"disk path fails", []string{"/", missing}, fakeNetwork([2]uint64{1000, 1000}, [2]uint64{2000, 2000})},
+		{"no interfaces left", []string{"/"}, noInterfaces},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &UsageCollector{
+				DiskPaths:   tt.diskPaths,
+				elapsed:     fakeClock(10*time.Second, 12*time.Second),
+				readNetwork: tt.readNetwork,
+			}
+
+			u.Collect()
+			usage, err := u.Collect()
+			var warning *CollectWarning
+			if !errors.As(err, &warning) {
+				t.Fatalf("Collect error = %v, want *CollectWarning", err)
+			}
+			if _, ok := usage.DiskUsageRates["/"]; !ok {
+				t.Fatal("disk usage for / is missing")
+			}
+			if _, ok := usage.DiskUsageRates[missing]; ok {
+				t.Fatalf("disk usage reported for missing path %s", missing)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Weblin Project Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+/*
+Package systemd systemd 연동 공용 함수 패키지
+*/
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// sd_notify 상태 메시지
+const (
+	// 서비스 시작 완료
+	NotifyReady = "READY=1"
+	// 서비스 종료 시작
+	NotifyStopping = "STOPPING=1"
+	// 워치독 keep-alive
+	NotifyWatchdog = "WATCHDOG=1"
+)
+
+// Notify systemd에 서비스 상태 전송 (sd_notify)
+//
+// NOTIFY_SOCKET 환경 변수가 없으면(systemd 관리 하에 있지 않으면) 아무 작업도 하지 않음
+//
+// Parameters:
+//   - state: 상태 메시지 (ex: NotifyReady)
+//
+// Returns:
+//   - bool: 전송(true), 미전송(false)
+//   - error: 성공(nil), 실패(error)
+func Notify(state string) (bool, error) {
+	socketAddr := os.Getenv("NOTIFY_SOCKET")
+	if socketAddr == "" {
+		return false, nil
+	}
+
+	// '@'로 시작하는 주소는 추상 네임스페이스 소켓
+	if socketAddr[0] == '@' {
+		socketAddr = "\x00" + socketAddr[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socketAddr, Net: "unixgram"})
+	if err != nil {
+		return false, fmt.Errorf("failed to connect notify socket: %v", err)
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+	if err != nil {
+		return false, fmt.Errorf("failed to send notify state: %v", err)
+	}
+
+	return true, nil
+}
+
+// WatchdogInterval systemd 워치독 타임아웃 획득
+//
+// WATCHDOG_USEC 환경 변수가 없거나, WATCHDOG_PID가 현재 프로세스가 아니면 비활성으로 판단
+//
+// Returns:
+//   - time.Duration: 워치독 타임아웃
+//   - bool: 워치독 활성(true), 비활성(false)
+func WatchdogInterval() (time.Duration, bool) {
+	usecStr := os.Getenv("WATCHDOG_USEC")
+	if usecStr == "" {
+		return 0, false
+	}
+
+	if pidStr := os.Getenv("WATCHDOG_PID"); pidStr != "" {
+		pid, err := strconv.Atoi(pidStr)
+		if err != nil || pid != os.Getpid() {
+			return 0, false
+		}
+	}
+
+	usec, err := strconv.ParseInt(usecStr, 10, 64)
+	if err != nil || usec <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(usec) * time.Microsecond, true
+}